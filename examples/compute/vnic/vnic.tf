@@ -12,6 +12,10 @@ variable "secondary_vnic_count" {
   default = 1
 }
 
+variable "secondary_private_ip_count" {
+  default = 2
+}
+
 variable "instance_image_ocid" {
   type = "map"
 
@@ -99,6 +103,7 @@ resource "oci_core_vnic_attachment" "secondary_vnic_attachment" {
   create_vnic_details {
     subnet_id              = "${oci_core_subnet.test_subnet.id}"
     display_name           = "SecondaryVnic_${count.index}"
+    hostname_label         = "secondaryvnic${count.index}"
     assign_public_ip       = true
     skip_source_dest_check = true
     nsg_ids                = ["${oci_core_network_security_group.test_network_security_group.id}"]
@@ -107,6 +112,15 @@ resource "oci_core_vnic_attachment" "secondary_vnic_attachment" {
   count = "${var.secondary_vnic_count}"
 }
 
+# Secondary private IPs are added to the first secondary VNIC; they are removed along with the VNIC when it is detached
+resource "oci_core_private_ip" "secondary_private_ip" {
+  vnic_id        = "${oci_core_vnic_attachment.secondary_vnic_attachment.0.vnic_id}"
+  display_name   = "SecondaryPrivateIp_${count.index}"
+  hostname_label = "secondaryip${count.index}"
+
+  count = "${var.secondary_private_ip_count}"
+}
+
 data "oci_core_vnic" "secondary_vnic" {
   count   = "${var.secondary_vnic_count}"
   vnic_id = "${element(oci_core_vnic_attachment.secondary_vnic_attachment.*.vnic_id, count.index)}"
@@ -125,3 +139,7 @@ output "secondary_public_ip_addresses" {
 output "secondary_private_ip_addresses" {
   value = ["${data.oci_core_vnic.secondary_vnic.*.private_ip_address}"]
 }
+
+output "secondary_vnic_secondary_private_ip_addresses" {
+  value = ["${oci_core_private_ip.secondary_private_ip.*.ip_address}"]
+}
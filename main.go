@@ -23,7 +23,9 @@ func main() {
 	var outputPath = flag.String("output_path", "", "[export] Path to output generated configurations and state files of the exported compartment")
 	var services = flag.String("services", "", "[export] Comma-separated list of service resources to export. By default, all compartment-scope resources are exported.")
 	var ids = flag.String("ids", "", "[export] Comma-separated list of resource IDs to export. The ID could either be an OCID or a Terraform import ID. By default, all resources are exported.")
-	var generateStateFile = flag.Bool("generate_state", false, "[export][experimental] Set this to import the discovered resources into a state file along with the Terraform configuration")
+	var regions = flag.String("regions", "", "[export] Comma-separated list of regions to export the resources of regional services from, or 'all' for all the regions the tenancy is subscribed to. By default, the resources are exported from the region of the provider configuration.")
+	var excludeTypes = flag.String("exclude_types", "", "[export] Comma-separated list of patterns of resource types to leave out of the export, such as 'oci_core_instance' or 'oci_core_*'.")
+	var generateStateFile = flag.Bool("generate_state", false, "[export] Set this to write the state of the discovered resources into a state file along with the Terraform configuration")
 	var help = flag.Bool("help", false, "Prints usage options")
 
 	flag.Parse()
@@ -59,6 +61,14 @@ func main() {
 				args.IDs = strings.Split(*ids, ",")
 			}
 
+			if regions != nil && *regions != "" {
+				args.Regions = strings.Split(*regions, ",")
+			}
+
+			if excludeTypes != nil && *excludeTypes != "" {
+				args.ExcludeTypes = strings.Split(*excludeTypes, ",")
+			}
+
 			if err := provider.RunExportCommand(args); err != nil {
 				color.Red("%v", err)
 				os.Exit(1)
@@ -6,7 +6,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -143,28 +142,18 @@ func createAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) err
 	sync.D = d
 	sync.Client = m.(*OracleClients).analyticsClient
 
-	var powerOff = false
-	if powerState, ok := sync.D.GetOkExists("state"); ok {
-		wantedPowerState := oci_analytics.AnalyticsInstanceLifecycleStateEnum(strings.ToUpper(powerState.(string)))
-		if wantedPowerState == oci_analytics.AnalyticsInstanceLifecycleStateInactive {
-			powerOff = true
-		}
-	}
+	return CreateResource(d, sync)
+}
 
-	if e := CreateResource(d, sync); e != nil {
-		return e
-	}
+func (s *AnalyticsAnalyticsInstanceResourceCrud) RunningState() string {
+	return string(oci_analytics.AnalyticsInstanceLifecycleStateActive)
+}
 
-	if powerOff {
-		if err := sync.StopOacInstance(); err != nil {
-			return err
-		}
-		sync.D.Set("state", oci_analytics.AnalyticsInstanceLifecycleStateInactive)
-	}
-	return nil
+func (s *AnalyticsAnalyticsInstanceResourceCrud) StoppedState() string {
+	return string(oci_analytics.AnalyticsInstanceLifecycleStateInactive)
 }
 
-func (s *AnalyticsAnalyticsInstanceResourceCrud) StartOacInstance() error {
+func (s *AnalyticsAnalyticsInstanceResourceCrud) Start() error {
 	request := oci_analytics.StartAnalyticsInstanceRequest{}
 
 	tmp := s.D.Id()
@@ -181,7 +170,7 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) StartOacInstance() error {
 	return s.getAnalyticsInstanceFromWorkRequest(workId, getRetryPolicy(s.DisableNotFoundRetries, "analytics"), oci_analytics.WorkRequestActionResultStarted, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *AnalyticsAnalyticsInstanceResourceCrud) StopOacInstance() error {
+func (s *AnalyticsAnalyticsInstanceResourceCrud) Stop() error {
 	request := oci_analytics.StopAnalyticsInstanceRequest{}
 
 	tmp := s.D.Id()
@@ -211,37 +200,7 @@ func updateAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) err
 	sync.D = d
 	sync.Client = m.(*OracleClients).analyticsClient
 
-	// switch to power on
-	powerOn, powerOff := false, false
-
-	if sync.D.HasChange("state") {
-		wantedState := strings.ToUpper(sync.D.Get("state").(string))
-		if oci_analytics.AnalyticsInstanceLifecycleStateActive == oci_analytics.AnalyticsInstanceLifecycleStateEnum(wantedState) {
-			powerOn = true
-		} else if oci_analytics.AnalyticsInstanceLifecycleStateInactive == oci_analytics.AnalyticsInstanceLifecycleStateEnum(wantedState) {
-			powerOff = true
-		}
-	}
-
-	if powerOn {
-		if err := sync.StartOacInstance(); err != nil {
-			return err
-		}
-		sync.D.Set("state", oci_analytics.AnalyticsInstanceLifecycleStateActive)
-	}
-
-	if err := UpdateResource(d, sync); err != nil {
-		return err
-	}
-
-	// switch to power off
-	if powerOff {
-		if err := sync.StopOacInstance(); err != nil {
-			return err
-		}
-		sync.D.Set("state", oci_analytics.AnalyticsInstanceLifecycleStateInactive)
-	}
-	return nil
+	return UpdateResource(d, sync)
 }
 
 func deleteAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) error {
@@ -434,6 +393,7 @@ func analyticsInstanceWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Timeout: timeout,
 	}
+	setPollInterval(stateConf, providerPollInterval)
 	if _, e := stateConf.WaitForState(); e != nil {
 		return nil, e
 	}
@@ -473,11 +433,23 @@ func getErrorFromAnalyticsInstanceWorkRequest(client *oci_analytics.AnalyticsCli
 	for _, wrkErr := range response.Items {
 		allErrs = append(allErrs, *wrkErr.Message)
 	}
-	errorMessage := strings.Join(allErrs, "\n")
 
-	workRequestErr := fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *wId, entityType, action, errorMessage)
+	logs := make([]string, 0)
+	logsResponse, err := client.ListWorkRequestLogs(context.Background(),
+		oci_analytics.ListWorkRequestLogsRequest{
+			WorkRequestId: wId,
+			RequestMetadata: oci_common.RequestMetadata{
+				RetryPolicy: retryPolicy,
+			},
+		})
+	if err != nil {
+		log.Printf("[WARN] Could not list the log entries of work request %s: %v", *wId, err)
+	}
+	for _, logEntry := range logsResponse.Items {
+		logs = append(logs, *logEntry.Message)
+	}
 
-	return workRequestErr
+	return newWorkRequestError(wId, entityType, string(action), response.OpcRequestId, allErrs, logs)
 }
 
 func (s *AnalyticsAnalyticsInstanceResourceCrud) Get() error {
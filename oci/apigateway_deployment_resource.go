@@ -807,6 +807,7 @@ func deploymentWaitForWorkRequest(wId *string, entityType string, action oci_api
 		},
 		Timeout: timeout,
 	}
+	setPollInterval(stateConf, providerPollInterval)
 	if _, e := stateConf.WaitForState(); e != nil {
 		return nil, e
 	}
@@ -4,7 +4,6 @@ package oci
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -291,6 +290,7 @@ func gatewayWaitForWorkRequest(wId *string, entityType string, action oci_apigat
 		},
 		Timeout: timeout,
 	}
+	setPollInterval(stateConf, providerPollInterval)
 	if _, e := stateConf.WaitForState(); e != nil {
 		return nil, e
 	}
@@ -330,11 +330,23 @@ func getErrorFromGatewayWorkRequest(client *oci_apigateway.WorkRequestsClient, w
 	for _, wrkErr := range response.Items {
 		allErrs = append(allErrs, *wrkErr.Message)
 	}
-	errorMessage := strings.Join(allErrs, "\n")
 
-	workRequestErr := fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *wId, entityType, action, errorMessage)
+	logs := make([]string, 0)
+	logsResponse, err := client.ListWorkRequestLogs(context.Background(),
+		oci_apigateway.ListWorkRequestLogsRequest{
+			WorkRequestId: wId,
+			RequestMetadata: oci_common.RequestMetadata{
+				RetryPolicy: retryPolicy,
+			},
+		})
+	if err != nil {
+		log.Printf("[WARN] Could not list the log entries of work request %s: %v", *wId, err)
+	}
+	for _, logEntry := range logsResponse.Items {
+		logs = append(logs, *logEntry.Message)
+	}
 
-	return workRequestErr
+	return newWorkRequestError(wId, entityType, string(action), response.OpcRequestId, allErrs, logs)
 }
 
 func (s *ApigatewayGatewayResourceCrud) Get() error {
@@ -0,0 +1,224 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	tokenExchangeDomainUrlEnv    = "token_exchange_domain_url"
+	tokenExchangeClientIdEnv     = "token_exchange_client_id"
+	tokenExchangeClientSecretEnv = "token_exchange_client_secret"
+	tokenExchangeTokenFileEnv    = "token_exchange_token_file"
+
+	tokenExchangeGrantType     = "urn:ietf:params:oauth:grant-type:token-exchange"
+	tokenExchangeRequestedType = "urn:oci:token-type:oci-upst"
+	tokenExchangeSubjectType   = "jwt"
+
+	// Exchange the token again when the session token is about to expire, so that requests are never signed with an expired token
+	tokenExchangeRefreshWindow = 1 * time.Minute
+)
+
+// tokenExchangeConfigProvider signs requests with a session token obtained by exchanging an OIDC token, such as the
+// identity token of a CI job, at the token exchange endpoint of an identity domain. The session token is bound to a
+// key pair that is generated in memory, so no long-lived API key is needed.
+type tokenExchangeConfigProvider struct {
+	tenancyOcid  string
+	region       string
+	domainUrl    string
+	clientId     string
+	clientSecret string
+	tokenFile    string
+	httpClient   *http.Client
+
+	lock          sync.Mutex
+	privateKey    *rsa.PrivateKey
+	sessionToken  string
+	sessionExpiry time.Time
+
+	// The key pair and session token last handed to the signer, which are used together to sign a request
+	signingKey   *rsa.PrivateKey
+	signingToken string
+}
+
+func newTokenExchangeConfigProvider(tenancyOcid string, region string) (*tokenExchangeConfigProvider, error) {
+	provider := &tokenExchangeConfigProvider{
+		tenancyOcid:  tenancyOcid,
+		region:       region,
+		domainUrl:    strings.TrimSuffix(getEnvSettingWithBlankDefault(tokenExchangeDomainUrlEnv), "/"),
+		clientId:     getEnvSettingWithBlankDefault(tokenExchangeClientIdEnv),
+		clientSecret: getEnvSettingWithBlankDefault(tokenExchangeClientSecretEnv),
+		tokenFile:    getEnvSettingWithBlankDefault(tokenExchangeTokenFileEnv),
+		httpClient:   buildHttpClient(),
+	}
+
+	for setting, value := range map[string]string{
+		tokenExchangeDomainUrlEnv:    provider.domainUrl,
+		tokenExchangeClientIdEnv:     provider.clientId,
+		tokenExchangeClientSecretEnv: provider.clientSecret,
+		tokenExchangeTokenFileEnv:    provider.tokenFile,
+	} {
+		if value == "" {
+			return nil, fmt.Errorf("can not get %s from the environment (%s)", setting, authTokenExchangeSetting)
+		}
+	}
+	return provider, nil
+}
+
+func (p *tokenExchangeConfigProvider) TenancyOCID() (string, error) {
+	return p.tenancyOcid, nil
+}
+
+// Session tokens are not tied to a user or an API key, so these are not required
+func (p *tokenExchangeConfigProvider) UserOCID() (string, error) {
+	return "", nil
+}
+
+func (p *tokenExchangeConfigProvider) KeyFingerprint() (string, error) {
+	return "", nil
+}
+
+func (p *tokenExchangeConfigProvider) Region() (string, error) {
+	return p.region, nil
+}
+
+// KeyID returns the session token that goes with the key returned by the last call to PrivateRSAKey. It does not
+// refresh the session token itself, so a request is never signed with one key pair and sent with the token of another.
+func (p *tokenExchangeConfigProvider) KeyID() (string, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.signingToken == "" {
+		if err := p.takeSigningSnapshot(); err != nil {
+			return "", err
+		}
+	}
+	return "ST$" + p.signingToken, nil
+}
+
+// PrivateRSAKey is called first when a request is signed, it refreshes the session token when needed and takes the
+// key pair and session token that the request is signed with
+func (p *tokenExchangeConfigProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if err := p.takeSigningSnapshot(); err != nil {
+		return nil, err
+	}
+	return p.signingKey, nil
+}
+
+// takeSigningSnapshot is the only place the session token is refreshed, the key pair and session token are copied
+// together while the lock is held
+func (p *tokenExchangeConfigProvider) takeSigningSnapshot() error {
+	if err := p.refreshSessionToken(); err != nil {
+		return err
+	}
+	p.signingKey = p.privateKey
+	p.signingToken = p.sessionToken
+	return nil
+}
+
+func (p *tokenExchangeConfigProvider) refreshSessionToken() error {
+	if p.sessionToken != "" && time.Now().Add(tokenExchangeRefreshWindow).Before(p.sessionExpiry) {
+		return nil
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return fmt.Errorf("can not generate a key pair for the session token: %v", err)
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return fmt.Errorf("can not encode the public key for the session token: %v", err)
+	}
+
+	// The OIDC token is read for every exchange, since CI systems replace it with a new token before it expires
+	subjectToken, err := ioutil.ReadFile(p.tokenFile)
+	if err != nil {
+		return fmt.Errorf("can not read the token to exchange from: '%s', Error: %q", p.tokenFile, err)
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", tokenExchangeGrantType)
+	form.Set("requested_token_type", tokenExchangeRequestedType)
+	form.Set("subject_token", strings.TrimSpace(string(subjectToken)))
+	form.Set("subject_token_type", tokenExchangeSubjectType)
+	form.Set("public_key", base64.StdEncoding.EncodeToString(publicKey))
+
+	request, err := http.NewRequest(http.MethodPost, p.domainUrl+"/oauth2/v1/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.SetBasicAuth(p.clientId, p.clientSecret)
+
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("token exchange with %s failed: %v", p.domainUrl, err)
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("can not read the token exchange response: %v", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("token exchange with %s failed with status %d: %s", p.domainUrl, response.StatusCode, string(body))
+	}
+
+	var exchangeResponse struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal(body, &exchangeResponse); err != nil || exchangeResponse.Token == "" {
+		return fmt.Errorf("token exchange with %s did not return a session token", p.domainUrl)
+	}
+
+	expiry, err := getJwtExpiry(exchangeResponse.Token)
+	if err != nil {
+		return err
+	}
+
+	p.privateKey = privateKey
+	p.sessionToken = exchangeResponse.Token
+	p.sessionExpiry = expiry
+	log.Printf("[DEBUG] Exchanged token for a session token that expires at %s", expiry)
+	return nil
+}
+
+// getJwtExpiry returns the time a JWT expires at from its exp claim, the signature is verified by the services
+func getJwtExpiry(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("session token is not a valid JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("can not decode the session token: %v", err)
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}, fmt.Errorf("session token does not have an expiry")
+	}
+	return time.Unix(claims.Exp, 0), nil
+}
+
+func (p *tokenExchangeConfigProvider) String() string {
+	return fmt.Sprintf("token exchange with %s", p.domainUrl)
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testSessionToken(expiry time.Time) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"sub":"ci","exp":%d}`, expiry.Unix())))
+	return "header." + payload + ".signature"
+}
+
+func TestUnitTokenExchangeConfigProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "token_exchange")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	tokenFile := path.Join(dir, "oidc_token")
+	assert.Nil(t, ioutil.WriteFile(tokenFile, []byte("oidc-token-1\n"), 0600))
+
+	exchanges := 0
+	expiry := time.Now().Add(time.Hour)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		exchanges++
+		clientId, clientSecret, _ := r.BasicAuth()
+		assert.Equal(t, "/oauth2/v1/token", r.URL.Path)
+		assert.Equal(t, "client", clientId)
+		assert.Equal(t, "secret", clientSecret)
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, tokenExchangeGrantType, r.PostForm.Get("grant_type"))
+		assert.Equal(t, tokenExchangeRequestedType, r.PostForm.Get("requested_token_type"))
+		assert.Equal(t, fmt.Sprintf("oidc-token-%d", exchanges), r.PostForm.Get("subject_token"))
+		assert.NotEmpty(t, r.PostForm.Get("public_key"))
+		fmt.Fprintf(w, `{"token":"%s"}`, testSessionToken(expiry))
+	}))
+	defer server.Close()
+
+	_, err = newTokenExchangeConfigProvider("tenancy", "us-phoenix-1")
+	assert.Error(t, err)
+
+	for setting, value := range map[string]string{
+		tokenExchangeDomainUrlEnv:    server.URL + "/",
+		tokenExchangeClientIdEnv:     "client",
+		tokenExchangeClientSecretEnv: "secret",
+		tokenExchangeTokenFileEnv:    tokenFile,
+	} {
+		os.Setenv(setting, value)
+		defer os.Unsetenv(setting)
+	}
+
+	provider, err := newTokenExchangeConfigProvider("tenancy", "us-phoenix-1")
+	assert.Nil(t, err)
+
+	keyId, err := provider.KeyID()
+	assert.Nil(t, err)
+	assert.Equal(t, "ST$"+testSessionToken(expiry), keyId)
+	privateKey, err := provider.PrivateRSAKey()
+	assert.Nil(t, err)
+	assert.NotNil(t, privateKey)
+	assert.Equal(t, 1, exchanges)
+
+	tenancy, err := provider.TenancyOCID()
+	assert.Nil(t, err)
+	assert.Equal(t, "tenancy", tenancy)
+	userOcid, err := provider.UserOCID()
+	assert.Nil(t, err)
+	assert.Equal(t, "", userOcid)
+
+	// The token is exchanged again, with a new key pair, once the session token is about to expire
+	provider.sessionExpiry = time.Now().Add(tokenExchangeRefreshWindow / 2)
+	assert.Nil(t, ioutil.WriteFile(tokenFile, []byte("oidc-token-2"), 0600))
+	newPrivateKey, err := provider.PrivateRSAKey()
+	assert.Nil(t, err)
+	assert.NotEqual(t, privateKey, newPrivateKey)
+	assert.Equal(t, 2, exchanges)
+	newKeyId, err := provider.KeyID()
+	assert.Nil(t, err)
+	assert.Equal(t, "ST$"+provider.sessionToken, newKeyId)
+
+	// The key ID always goes with the last key that was handed out, even when the session token is about to expire
+	provider.sessionExpiry = time.Now().Add(tokenExchangeRefreshWindow / 2)
+	keyId, err = provider.KeyID()
+	assert.Nil(t, err)
+	assert.Equal(t, newKeyId, keyId)
+	assert.Equal(t, 2, exchanges)
+}
+
+func TestUnitTokenExchangeConfigProvider_exchangeFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"error":"invalid_client"}`)
+	}))
+	defer server.Close()
+
+	tokenFile, err := ioutil.TempFile("", "oidc_token")
+	assert.Nil(t, err)
+	defer os.Remove(tokenFile.Name())
+
+	provider := &tokenExchangeConfigProvider{
+		domainUrl:  server.URL,
+		tokenFile:  tokenFile.Name(),
+		httpClient: http.DefaultClient,
+	}
+	_, err = provider.KeyID()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid_client")
+}
+
+func TestUnitGetJwtExpiry(t *testing.T) {
+	expiry := time.Unix(1900000000, 0)
+	actual, err := getJwtExpiry(testSessionToken(expiry))
+	assert.Nil(t, err)
+	assert.Equal(t, expiry, actual)
+
+	_, err = getJwtExpiry("not-a-jwt")
+	assert.Error(t, err)
+	_, err = getJwtExpiry("header." + base64.RawURLEncoding.EncodeToString([]byte(`{}`)) + ".signature")
+	assert.Error(t, err)
+}
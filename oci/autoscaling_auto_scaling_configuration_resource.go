@@ -25,11 +25,12 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: DefaultTimeout,
-		Create:   createAutoScalingAutoScalingConfiguration,
-		Read:     readAutoScalingAutoScalingConfiguration,
-		Update:   updateAutoScalingAutoScalingConfiguration,
-		Delete:   deleteAutoScalingAutoScalingConfiguration,
+		Timeouts:      DefaultTimeout,
+		Create:        createAutoScalingAutoScalingConfiguration,
+		Read:          readAutoScalingAutoScalingConfiguration,
+		Update:        updateAutoScalingAutoScalingConfiguration,
+		Delete:        deleteAutoScalingAutoScalingConfiguration,
+		CustomizeDiff: validateAutoScalingPolicyCapacities,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"auto_scaling_resources": {
@@ -50,6 +51,9 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"instancePool",
+							}, false),
 						},
 
 						// Optional
@@ -267,6 +271,33 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 	}
 }
 
+// The initial number of instances of each policy must lie between its minimum and maximum number of instances
+func validateAutoScalingPolicyCapacities(d *schema.ResourceDiff, meta interface{}) error {
+	policies, ok := d.Get("policies").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	for i := range policies {
+		fieldKeyFormat := fmt.Sprintf("policies.%d.capacity.0.%%s", i)
+		if !d.NewValueKnown(fmt.Sprintf(fieldKeyFormat, "initial")) || !d.NewValueKnown(fmt.Sprintf(fieldKeyFormat, "max")) || !d.NewValueKnown(fmt.Sprintf(fieldKeyFormat, "min")) {
+			continue
+		}
+
+		initial := d.Get(fmt.Sprintf(fieldKeyFormat, "initial")).(int)
+		max := d.Get(fmt.Sprintf(fieldKeyFormat, "max")).(int)
+		min := d.Get(fmt.Sprintf(fieldKeyFormat, "min")).(int)
+		if min > max {
+			return fmt.Errorf("invalid capacity of policies.%d: min %d is greater than max %d", i, min, max)
+		}
+		if initial < min || initial > max {
+			return fmt.Errorf("invalid capacity of policies.%d: initial %d is not between min %d and max %d", i, initial, min, max)
+		}
+	}
+
+	return nil
+}
+
 func createAutoScalingAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
 	sync := &AutoScalingAutoScalingConfigurationResourceCrud{}
 	sync.D = d
@@ -5,6 +5,7 @@ package oci
 import (
 	"context"
 	"fmt"
+	"log"
 	"strconv"
 	"strings"
 	"time"
@@ -775,6 +776,7 @@ func bdsInstanceWaitForWorkRequest(wId *string, entityType string, action oci_bd
 		},
 		Timeout: timeout,
 	}
+	setPollInterval(stateConf, providerPollInterval)
 	if _, e := stateConf.WaitForState(); e != nil {
 		return nil, e
 	}
@@ -815,11 +817,23 @@ func getErrorFromBdsInstanceWorkRequest(client *oci_bds.BdsClient, wId *string,
 	for _, wrkErr := range response.Items {
 		allErrs = append(allErrs, *wrkErr.Message)
 	}
-	errorMessage := strings.Join(allErrs, "\n")
 
-	workRequestErr := fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *wId, entityType, action, errorMessage)
+	logs := make([]string, 0)
+	logsResponse, err := client.ListWorkRequestLogs(context.Background(),
+		oci_bds.ListWorkRequestLogsRequest{
+			WorkRequestId: wId,
+			RequestMetadata: oci_common.RequestMetadata{
+				RetryPolicy: retryPolicy,
+			},
+		})
+	if err != nil {
+		log.Printf("[WARN] Could not list the log entries of work request %s: %v", *wId, err)
+	}
+	for _, logEntry := range logsResponse.Items {
+		logs = append(logs, *logEntry.Message)
+	}
 
-	return workRequestErr
+	return newWorkRequestError(wId, entityType, string(action), response.OpcRequestId, allErrs, logs)
 }
 
 func (s *BdsBdsInstanceResourceCrud) Get() error {
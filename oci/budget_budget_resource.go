@@ -4,12 +4,18 @@ package oci
 
 import (
 	"context"
+	"fmt"
+	"regexp"
 
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/schema"
 
 	oci_budget "github.com/oracle/oci-go-sdk/budget"
 )
 
+// Cost tracking tag targets are of the form "{tagNamespace}.{tagKey}.{tagValue}"
+var budgetTagTargetRegex = regexp.MustCompile(`^[^.]+\.[^.]+\.[^.]+$`)
+
 func init() {
 	RegisterResource("oci_budget_budget", BudgetBudgetResource())
 }
@@ -123,7 +129,33 @@ func BudgetBudgetResource() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		// The service only rejects malformed tag targets on create, so catch them at plan time instead
+		CustomizeDiff: customdiff.All(
+			validateBudgetTagTargets,
+		),
+	}
+}
+
+func validateBudgetTagTargets(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("target_type") || !d.NewValueKnown("targets") {
+		return nil
+	}
+
+	if targetType := d.Get("target_type").(string); targetType != string(oci_budget.TargetTypeTag) {
+		return nil
 	}
+
+	for _, target := range d.Get("targets").([]interface{}) {
+		if target == nil {
+			continue
+		}
+		if !budgetTagTargetRegex.MatchString(target.(string)) {
+			return fmt.Errorf("invalid target '%s' for target_type %s: expected the form {tagNamespace}.{tagKey}.{tagValue}", target, oci_budget.TargetTypeTag)
+		}
+	}
+
+	return nil
 }
 
 func createBudgetBudget(d *schema.ResourceData, m interface{}) error {
@@ -5,6 +5,7 @@ package oci
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -182,6 +183,14 @@ func TestBudgetBudgetResource_basic(t *testing.T) {
 					},
 				),
 			},
+			// verify malformed tag targets are rejected at plan time
+			{
+				Config: config + compartmentIdVariableStr + BudgetResourceDependencies +
+					generateResourceFromRepresentationMap("oci_budget_budget", "test_budget", Required, Create,
+						getUpdatedRepresentationCopy("targets", Representation{repType: Required, create: []string{`CostCenter.test`}}, budgetRepresentationWithTargetTypeAsTagAndTargets)),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("expected the form \\{tagNamespace\\}.\\{tagKey\\}.\\{tagValue\\}"),
+			},
 			// verify create for TargetType = Tag
 			{
 				Config: config + compartmentIdVariableStr + BudgetResourceDependencies +
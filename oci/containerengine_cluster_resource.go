@@ -386,6 +386,8 @@ func containerEngineWaitForWorkRequest(wId *string, entityType string, action oc
 		Timeout: timeout,
 	}
 
+	setPollInterval(stateConf, providerPollInterval)
+
 	// Set PollInterval to 1 for replay mode.
 	if httpreplay.ShouldRetryImmediately() {
 		stateConf.PollInterval = 1
@@ -407,8 +409,7 @@ func containerEngineWaitForWorkRequest(wId *string, entityType string, action oc
 	}
 
 	//Otherwise the operation ended unsucessfully
-	errorMessage, _ := getErrorFromWorkRequest(wId, response.CompartmentId, client, disableFoundRetries)
-	return identifier, fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *wId, entityType, action, errorMessage)
+	return identifier, getErrorFromWorkRequest(wId, response.CompartmentId, client, disableFoundRetries, entityType, action)
 }
 
 func (s *ContainerengineClusterResourceCrud) Create() error {
@@ -867,16 +868,16 @@ func KubernetesNetworkConfigToMap(obj *oci_containerengine.KubernetesNetworkConf
 	return result
 }
 
-// getErrorFromWorkRequest retuns a concatened string of all errors for a given work request, if there is a reading the error it returns an empty string an error
-func getErrorFromWorkRequest(workRequestId *string, compartmentId *string, client *oci_containerengine.ContainerEngineClient, disableFoundAutoRetries bool) (string, error) {
+// getErrorFromWorkRequest returns the error for a work request that did not succeed, with the errors and log entries of the work request.
+// Errors and log entries that can not be read are left out of the error.
+func getErrorFromWorkRequest(workRequestId *string, compartmentId *string, client *oci_containerengine.ContainerEngineClient, disableFoundAutoRetries bool, entityType string, action oci_containerengine.WorkRequestResourceActionTypeEnum) error {
 	req := oci_containerengine.ListWorkRequestErrorsRequest{}
 	req.WorkRequestId = workRequestId
 	req.CompartmentId = compartmentId
 	req.RequestMetadata.RetryPolicy = getRetryPolicy(disableFoundAutoRetries, "containerengine")
 	res, err := client.ListWorkRequestErrors(context.Background(), req)
-
 	if err != nil {
-		return "", err
+		log.Printf("[WARN] Could not list the errors of work request %s: %v", *workRequestId, err)
 	}
 
 	allErrs := make([]string, 0)
@@ -884,6 +885,21 @@ func getErrorFromWorkRequest(workRequestId *string, compartmentId *string, clien
 		allErrs = append(allErrs, *errs.Message)
 	}
 
-	errorMessage := strings.Join(allErrs, "\n")
-	return errorMessage, nil
+	logsReq := oci_containerengine.ListWorkRequestLogsRequest{}
+	logsReq.WorkRequestId = workRequestId
+	logsReq.CompartmentId = compartmentId
+	logsReq.RequestMetadata.RetryPolicy = getRetryPolicy(disableFoundAutoRetries, "containerengine")
+	logsRes, err := client.ListWorkRequestLogs(context.Background(), logsReq)
+	if err != nil {
+		log.Printf("[WARN] Could not list the log entries of work request %s: %v", *workRequestId, err)
+	}
+
+	logs := make([]string, 0)
+	for _, logEntry := range logsRes.Items {
+		if logEntry.Message != nil {
+			logs = append(logs, *logEntry.Message)
+		}
+	}
+
+	return newWorkRequestError(workRequestId, entityType, string(action), res.OpcRequestId, allErrs, logs)
 }
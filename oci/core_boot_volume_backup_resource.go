@@ -161,7 +161,7 @@ func createCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if ok && compartment != *sync.Res.CompartmentId {
-		err = sync.updateCompartment(compartment)
+		err = sync.ChangeCompartment(compartment)
 		if err != nil {
 			return err
 		}
@@ -344,6 +344,8 @@ func (s *CoreBootVolumeBackupResourceCrud) createBootVolumeBackupCopy() error {
 		copyBootVolumeBackupRequest.DisplayName = &tmp
 	}
 
+	copyBootVolumeBackupRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
 	response, err := s.SourceRegionClient.CopyBootVolumeBackup(context.Background(), copyBootVolumeBackupRequest)
 	if err != nil {
 		return err
@@ -371,15 +373,6 @@ func (s *CoreBootVolumeBackupResourceCrud) Get() error {
 }
 
 func (s *CoreBootVolumeBackupResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	//check if there are any fields is set (empty update request is invalid)
 	hasAttributeSet := false
 
@@ -501,7 +494,7 @@ func (s *CoreBootVolumeBackupResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreBootVolumeBackupResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreBootVolumeBackupResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeBootVolumeBackupCompartmentRequest{}
 
 	idTmp := s.D.Id()
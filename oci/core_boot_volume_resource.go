@@ -332,15 +332,6 @@ func (s *CoreBootVolumeResourceCrud) Get() error {
 }
 
 func (s *CoreBootVolumeResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateBootVolumeRequest{}
 
 	tmp := s.D.Id()
@@ -552,7 +543,7 @@ func BootVolumeSourceDetailsToMap(obj *oci_core.BootVolumeSourceDetails) map[str
 	return result
 }
 
-func (s *CoreBootVolumeResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreBootVolumeResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeBootVolumeCompartmentRequest{}
 
 	idTmp := s.D.Id()
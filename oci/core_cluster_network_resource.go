@@ -428,15 +428,6 @@ func (s *CoreClusterNetworkResourceCrud) Get() error {
 }
 
 func (s *CoreClusterNetworkResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateClusterNetworkRequest{}
 
 	tmp := s.D.Id()
@@ -691,7 +682,7 @@ func secondaryVnicSubnetsHashCodeForSets(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
-func (s *CoreClusterNetworkResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreClusterNetworkResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeClusterNetworkCompartmentRequest{}
 
 	idTmp := s.D.Id()
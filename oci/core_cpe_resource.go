@@ -178,15 +178,6 @@ func (s *CoreCpeResourceCrud) Get() error {
 }
 
 func (s *CoreCpeResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateCpeRequest{}
 
 	if cpeDeviceShapeId, ok := s.D.GetOkExists("cpe_device_shape_id"); ok {
@@ -267,7 +258,7 @@ func (s *CoreCpeResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreCpeResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreCpeResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeCpeCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
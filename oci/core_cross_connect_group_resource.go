@@ -210,15 +210,6 @@ func (s *CoreCrossConnectGroupResourceCrud) Get() error {
 }
 
 func (s *CoreCrossConnectGroupResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateCrossConnectGroupRequest{}
 
 	tmp := s.D.Id()
@@ -297,7 +288,7 @@ func (s *CoreCrossConnectGroupResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreCrossConnectGroupResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreCrossConnectGroupResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeCrossConnectGroupCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -291,15 +291,6 @@ func (s *CoreCrossConnectResourceCrud) Get() error {
 }
 
 func (s *CoreCrossConnectResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateCrossConnectRequest{}
 
 	tmp := s.D.Id()
@@ -403,7 +394,7 @@ func (s *CoreCrossConnectResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreCrossConnectResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreCrossConnectResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeCrossConnectCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
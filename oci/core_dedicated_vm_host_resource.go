@@ -236,15 +236,6 @@ func (s *CoreDedicatedVmHostResourceCrud) Get() error {
 }
 
 func (s *CoreDedicatedVmHostResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateDedicatedVmHostRequest{}
 
 	tmp := s.D.Id()
@@ -345,7 +336,7 @@ func (s *CoreDedicatedVmHostResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreDedicatedVmHostResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreDedicatedVmHostResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeDedicatedVmHostCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -283,15 +283,6 @@ func (s *CoreDhcpOptionsResourceCrud) Get() error {
 }
 
 func (s *CoreDhcpOptionsResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateDhcpOptionsRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -486,7 +477,7 @@ func optionsHashCodeForSets(v interface{}) int {
 	}
 	return hashcode.String(buf.String())
 }
-func (s *CoreDhcpOptionsResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreDhcpOptionsResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeDhcpOptionsCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -201,15 +201,6 @@ func (s *CoreDrgResourceCrud) Get() error {
 }
 
 func (s *CoreDrgResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateDrgRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -283,7 +274,7 @@ func (s *CoreDrgResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreDrgResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreDrgResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeDrgCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -0,0 +1,193 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	oci_core "github.com/oracle/oci-go-sdk/core"
+	oci_work_requests "github.com/oracle/oci-go-sdk/workrequests"
+)
+
+func init() {
+	RegisterResource("oci_core_image_export", CoreImageExportResource())
+}
+
+func CoreImageExportResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: getTimeoutDuration("2h"),
+		},
+		Create: createCoreImageExport,
+		Read:   readCoreImageExport,
+		Delete: deleteCoreImageExport,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"destination_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					"objectStorageTuple",
+					"objectStorageUri",
+				}, true),
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"bucket_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"destination_uri": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"object_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// Computed
+		},
+	}
+}
+
+func createCoreImageExport(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreImageExportResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).computeClient
+	sync.workRequestClient = m.(*OracleClients).workRequestClient
+
+	return CreateResource(d, sync)
+}
+
+func readCoreImageExport(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+func deleteCoreImageExport(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+type CoreImageExportResourceCrud struct {
+	BaseCrud
+	Client                 *oci_core.ComputeClient
+	workRequestClient      *oci_work_requests.WorkRequestClient
+	Res                    *oci_core.Image
+	DisableNotFoundRetries bool
+}
+
+// The same image can be exported to several destinations, so the ID of an export includes its destination
+func (s *CoreImageExportResourceCrud) ID() string {
+	return getImageExportCompositeId(*s.Res.Id, s.destination())
+}
+
+// destination returns the object storage URI, or the namespace, bucket and object, the image is exported to
+func (s *CoreImageExportResourceCrud) destination() string {
+	if strings.EqualFold(s.D.Get("destination_type").(string), "objectStorageUri") {
+		return s.D.Get("destination_uri").(string)
+	}
+	return fmt.Sprintf("n/%s/b/%s/o/%s", s.D.Get("namespace_name").(string), s.D.Get("bucket_name").(string), s.D.Get("object_name").(string))
+}
+
+func (s *CoreImageExportResourceCrud) Create() error {
+	request := oci_core.ExportImageRequest{}
+
+	exportImageDetails, err := s.mapToExportImageDetails()
+	if err != nil {
+		return err
+	}
+	request.ExportImageDetails = exportImageDetails
+
+	if imageId, ok := s.D.GetOkExists("image_id"); ok {
+		tmp := imageId.(string)
+		request.ImageId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.ExportImage(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Image
+
+	// The image is exported when the work request succeeds, and the image is available again
+	workId := response.OpcWorkRequestId
+	if workId == nil {
+		return nil
+	}
+	_, err = WaitForWorkRequest(s.workRequestClient, workId, "image", oci_work_requests.WorkRequestResourceActionTypeRelated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, false)
+	return err
+}
+
+func (s *CoreImageExportResourceCrud) SetData() error {
+	return nil
+}
+
+func getImageExportCompositeId(imageId string, destination string) string {
+	imageId = url.PathEscape(imageId)
+	destination = url.PathEscape(destination)
+	compositeId := "images/" + imageId + "/exports/" + destination
+	return compositeId
+}
+
+func (s *CoreImageExportResourceCrud) mapToExportImageDetails() (oci_core.ExportImageDetails, error) {
+	var baseObject oci_core.ExportImageDetails
+	//discriminator
+	destinationTypeRaw, ok := s.D.GetOkExists("destination_type")
+	var destinationType string
+	if ok {
+		destinationType = destinationTypeRaw.(string)
+	} else {
+		destinationType = "" // default value
+	}
+	switch strings.ToLower(destinationType) {
+	case strings.ToLower("objectStorageTuple"):
+		details := oci_core.ExportImageViaObjectStorageTupleDetails{}
+		if bucketName, ok := s.D.GetOkExists("bucket_name"); ok {
+			tmp := bucketName.(string)
+			details.BucketName = &tmp
+		}
+		if namespaceName, ok := s.D.GetOkExists("namespace_name"); ok {
+			tmp := namespaceName.(string)
+			details.NamespaceName = &tmp
+		}
+		if objectName, ok := s.D.GetOkExists("object_name"); ok {
+			tmp := objectName.(string)
+			details.ObjectName = &tmp
+		}
+		baseObject = details
+	case strings.ToLower("objectStorageUri"):
+		details := oci_core.ExportImageViaObjectStorageUriDetails{}
+		if destinationUri, ok := s.D.GetOkExists("destination_uri"); ok {
+			tmp := destinationUri.(string)
+			details.DestinationUri = &tmp
+		}
+		baseObject = details
+	default:
+		return nil, fmt.Errorf("unknown destination_type '%v' was specified", destinationType)
+	}
+	return baseObject, nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+)
+
+var (
+	imageExportRepresentation = map[string]interface{}{
+		"destination_type": Representation{repType: Required, create: `objectStorageTuple`},
+		"image_id":         Representation{repType: Required, create: `${oci_core_image.test_image.id}`},
+		"bucket_name":      Representation{repType: Required, create: `${oci_objectstorage_bucket.test_bucket.name}`},
+		"namespace_name":   Representation{repType: Required, create: `${data.oci_objectstorage_namespace.test_namespace.namespace}`},
+		"object_name":      Representation{repType: Required, create: `exported-image`},
+	}
+
+	ImageExportResourceDependencies = ImageRequiredOnlyResource +
+		generateDataSourceFromRepresentationMap("oci_objectstorage_namespace", "test_namespace", Required, Create, namespaceSingularDataSourceRepresentation) +
+		generateResourceFromRepresentationMap("oci_objectstorage_bucket", "test_bucket", Required, Create, bucketRepresentation)
+)
+
+func TestCoreImageExportResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestCoreImageExportResource_basic")
+	defer httpreplay.SaveScenario()
+
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_core_image_export.test_image_export"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify export to a bucket
+			{
+				Config: config + compartmentIdVariableStr + ImageExportResourceDependencies +
+					generateResourceFromRepresentationMap("oci_core_image_export", "test_image_export", Required, Create, imageExportRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile("^images/.+/exports/n%2F.+%2Fb%2F.+%2Fo%2Fexported-image$")),
+					resource.TestCheckResourceAttrSet(resourceName, "bucket_name"),
+					resource.TestCheckResourceAttr(resourceName, "destination_type", "objectStorageTuple"),
+					resource.TestCheckResourceAttrSet(resourceName, "image_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "namespace_name"),
+					resource.TestCheckResourceAttr(resourceName, "object_name", "exported-image"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitGetImageExportCompositeId(t *testing.T) {
+	// Exports of the same image to different destinations have different IDs
+	assert.Equal(t, "images/ocid1.image.oc1..image/exports/n%2Fnamespace%2Fb%2Fbucket%2Fo%2Fexported-image",
+		getImageExportCompositeId("ocid1.image.oc1..image", "n/namespace/b/bucket/o/exported-image"))
+	assert.Equal(t, "images/ocid1.image.oc1..image/exports/https:%2F%2Fobjectstorage.us-phoenix-1.oraclecloud.com%2Fp%2Ftoken%2Fn%2Fnamespace%2Fb%2Fbucket%2Fo%2Fimage",
+		getImageExportCompositeId("ocid1.image.oc1..image", "https://objectstorage.us-phoenix-1.oraclecloud.com/p/token/n/namespace/b/bucket/o/image"))
+}
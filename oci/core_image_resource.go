@@ -108,6 +108,10 @@ func CoreImageResource() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(oci_core.ImageSourceDetailsSourceImageTypeQcow2),
+								string(oci_core.ImageSourceDetailsSourceImageTypeVmdk),
+							}, false),
 						},
 						"source_uri": {
 							Type:     schema.TypeString,
@@ -369,15 +373,6 @@ func (s *CoreImageResourceCrud) Get() error {
 }
 
 func (s *CoreImageResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateImageRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -556,7 +551,7 @@ func InstanceAgentFeaturesToMap(obj *oci_core.InstanceAgentFeatures) map[string]
 	return result
 }
 
-func (s *CoreImageResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreImageResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeImageCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -814,15 +814,6 @@ func (s *CoreInstanceConfigurationResourceCrud) Get() error {
 }
 
 func (s *CoreInstanceConfigurationResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateInstanceConfigurationRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -1905,7 +1896,7 @@ func (s *CoreInstanceConfigurationResourceCrud) populateTopLevelPolymorphicCreat
 	return nil
 }
 
-func (s *CoreInstanceConfigurationResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreInstanceConfigurationResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeInstanceConfigurationCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -125,29 +126,24 @@ func CoreInstancePoolResource() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						// Required
 						"backend_set_name": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"load_balancer_id": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"port": {
 							Type:     schema.TypeInt,
 							Required: true,
-							ForceNew: true,
 						},
 						"vnic_selection": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 
 						// Optional
@@ -413,15 +409,6 @@ func (s *CoreInstancePoolResourceCrud) Get() error {
 }
 
 func (s *CoreInstancePoolResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateInstancePoolRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -449,6 +436,12 @@ func (s *CoreInstancePoolResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.InstancePoolId = &tmp
 
+	if s.D.HasChange("load_balancers") {
+		if err := s.updateLoadBalancers(); err != nil {
+			return err
+		}
+	}
+
 	if placementConfigurations, ok := s.D.GetOkExists("placement_configurations"); ok {
 		interfaces := placementConfigurations.([]interface{})
 		tmp := make([]oci_core.UpdateInstancePoolPlacementConfigurationDetails, len(interfaces))
@@ -493,6 +486,104 @@ func (s *CoreInstancePoolResourceCrud) Update() error {
 	return nil
 }
 
+// updateLoadBalancers detaches the backend sets that were removed from load_balancers and attaches the ones that were
+// added, without recreating the pool. The pool accepts one attachment change at a time, so each change is waited on
+// before the next one is made.
+func (s *CoreInstancePoolResourceCrud) updateLoadBalancers() error {
+	oldRaw, newRaw := s.D.GetChange("load_balancers")
+	oldLoadBalancers := mapToAttachLoadBalancerDetailsList(oldRaw.([]interface{}))
+	newLoadBalancers := mapToAttachLoadBalancerDetailsList(newRaw.([]interface{}))
+
+	instancePoolId := s.D.Id()
+	for _, oldLoadBalancer := range oldLoadBalancers {
+		if containsAttachLoadBalancerDetails(newLoadBalancers, oldLoadBalancer) {
+			continue
+		}
+
+		request := oci_core.DetachLoadBalancerRequest{}
+		request.InstancePoolId = &instancePoolId
+		request.LoadBalancerId = oldLoadBalancer.LoadBalancerId
+		request.BackendSetName = oldLoadBalancer.BackendSetName
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+		if _, err := s.Client.DetachLoadBalancer(context.Background(), request); err != nil {
+			return err
+		}
+		if err := s.waitForLoadBalancerAttachments(); err != nil {
+			return err
+		}
+	}
+
+	for _, newLoadBalancer := range newLoadBalancers {
+		if containsAttachLoadBalancerDetails(oldLoadBalancers, newLoadBalancer) {
+			continue
+		}
+
+		request := oci_core.AttachLoadBalancerRequest{}
+		request.InstancePoolId = &instancePoolId
+		request.AttachLoadBalancerDetails = newLoadBalancer
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+		if _, err := s.Client.AttachLoadBalancer(context.Background(), request); err != nil {
+			return err
+		}
+		if err := s.waitForLoadBalancerAttachments(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *CoreInstancePoolResourceCrud) waitForLoadBalancerAttachments() error {
+	loadBalancerAttachmentsSettledFunc := func() bool {
+		for _, item := range s.Res.LoadBalancers {
+			if item.LifecycleState == oci_core.InstancePoolLoadBalancerAttachmentLifecycleStateAttaching ||
+				item.LifecycleState == oci_core.InstancePoolLoadBalancerAttachmentLifecycleStateDetaching {
+				return false
+			}
+		}
+		return s.Res.LifecycleState == oci_core.InstancePoolLifecycleStateRunning ||
+			s.Res.LifecycleState == oci_core.InstancePoolLifecycleStateStopped
+	}
+	return WaitForResourceCondition(s, loadBalancerAttachmentsSettledFunc, s.D.Timeout(schema.TimeoutUpdate))
+}
+
+func mapToAttachLoadBalancerDetailsList(loadBalancers []interface{}) []oci_core.AttachLoadBalancerDetails {
+	result := []oci_core.AttachLoadBalancerDetails{}
+	for _, item := range loadBalancers {
+		loadBalancer, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		details := oci_core.AttachLoadBalancerDetails{}
+		if backendSetName, ok := loadBalancer["backend_set_name"].(string); ok {
+			details.BackendSetName = &backendSetName
+		}
+		if loadBalancerId, ok := loadBalancer["load_balancer_id"].(string); ok {
+			details.LoadBalancerId = &loadBalancerId
+		}
+		if port, ok := loadBalancer["port"].(int); ok {
+			details.Port = &port
+		}
+		if vnicSelection, ok := loadBalancer["vnic_selection"].(string); ok {
+			details.VnicSelection = &vnicSelection
+		}
+		result = append(result, details)
+	}
+	return result
+}
+
+func containsAttachLoadBalancerDetails(loadBalancers []oci_core.AttachLoadBalancerDetails, loadBalancer oci_core.AttachLoadBalancerDetails) bool {
+	for _, item := range loadBalancers {
+		if reflect.DeepEqual(item, loadBalancer) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *CoreInstancePoolResourceCrud) Delete() error {
 	request := oci_core.TerminateInstancePoolRequest{}
 
@@ -526,6 +617,9 @@ func (s *CoreInstancePoolResourceCrud) SetData() error {
 
 	loadBalancers := []interface{}{}
 	for _, item := range s.Res.LoadBalancers {
+		if item.LifecycleState == oci_core.InstancePoolLoadBalancerAttachmentLifecycleStateDetached {
+			continue
+		}
 		loadBalancers = append(loadBalancers, InstancePoolLoadBalancerAttachmentToMap(item))
 	}
 	s.D.Set("load_balancers", loadBalancers)
@@ -763,7 +857,7 @@ func InstancePoolPlacementSecondaryVnicSubnetToMap(obj oci_core.InstancePoolPlac
 	return result
 }
 
-func (s *CoreInstancePoolResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreInstancePoolResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeInstancePoolCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
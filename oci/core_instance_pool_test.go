@@ -59,7 +59,7 @@ var (
 	instancePoolLoadBalancersRepresentation = map[string]interface{}{
 		"backend_set_name": Representation{repType: Required, create: `${oci_load_balancer_backend_set.test_backend_set.name}`},
 		"load_balancer_id": Representation{repType: Required, create: `${oci_load_balancer_load_balancer.test_load_balancer.id}`},
-		"port":             Representation{repType: Required, create: `10`},
+		"port":             Representation{repType: Required, create: `10`, update: `11`},
 		"vnic_selection":   Representation{repType: Required, create: `PrimaryVnic`},
 	}
 	instancePoolPlacementConfigurationsSecondaryVnicSubnetsRepresentation = map[string]interface{}{
@@ -277,7 +277,7 @@ func TestCoreInstancePoolResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "load_balancers.0.id"),
 					resource.TestCheckResourceAttrSet(resourceName, "load_balancers.0.instance_pool_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "load_balancers.0.load_balancer_id"),
-					resource.TestCheckResourceAttr(resourceName, "load_balancers.0.port", "10"),
+					resource.TestCheckResourceAttr(resourceName, "load_balancers.0.port", "11"),
 					resource.TestCheckResourceAttrSet(resourceName, "load_balancers.0.state"),
 					resource.TestCheckResourceAttr(resourceName, "load_balancers.0.vnic_selection", "PrimaryVnic"),
 					resource.TestCheckResourceAttr(resourceName, "placement_configurations.#", "1"),
@@ -391,7 +391,7 @@ func TestCoreInstancePoolResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(singularDatasourceName, "load_balancers.#", "1"),
 					resource.TestCheckResourceAttrSet(singularDatasourceName, "load_balancers.0.id"),
 					resource.TestCheckResourceAttrSet(singularDatasourceName, "load_balancers.0.instance_pool_id"),
-					resource.TestCheckResourceAttr(singularDatasourceName, "load_balancers.0.port", "10"),
+					resource.TestCheckResourceAttr(singularDatasourceName, "load_balancers.0.port", "11"),
 					resource.TestCheckResourceAttrSet(singularDatasourceName, "load_balancers.0.state"),
 					resource.TestCheckResourceAttr(singularDatasourceName, "load_balancers.0.vnic_selection", "PrimaryVnic"),
 					resource.TestCheckResourceAttr(singularDatasourceName, "placement_configurations.#", "1"),
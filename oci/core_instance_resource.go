@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -23,7 +24,7 @@ import (
 )
 
 func init() {
-	RegisterResource("oci_core_instance", CoreInstanceResource())
+	RegisterResource("oci_core_instance", addStateUpgraders(CoreInstanceResource(), upgradeCoreInstanceStateV0))
 }
 
 func CoreInstanceResource() *schema.Resource {
@@ -454,50 +455,92 @@ func CoreInstanceResource() *schema.Resource {
 			},
 		},
 		// CustomizeDiff for Instance resource
-		// Updates of 'ssh_authorized_keys' and 'user_data' in Instance 'metadata' should result in Force New
+		// Updates of 'ssh_authorized_keys' and 'user_data' in Instance 'metadata' or 'extended_metadata' should result
+		// in Force New, the other keys are updated in place
 		CustomizeDiff: customdiff.All(
-			customdiff.ForceNewIfChange("metadata", func(old, new, meta interface{}) bool {
-				oldMetadataMap := objectMapToStringMap(old.(map[string]interface{}))
-				newMetadataMap := objectMapToStringMap(new.(map[string]interface{}))
-				return (oldMetadataMap["ssh_authorized_keys"] != newMetadataMap["ssh_authorized_keys"]) || (oldMetadataMap["user_data"] != newMetadataMap["user_data"])
-			}),
+			customdiff.ForceNewIfChange("metadata", instanceLaunchOnlyMetadataChanged),
+			customdiff.ForceNewIfChange("extended_metadata", instanceLaunchOnlyMetadataChanged),
+			checkCoreInstanceServiceLimits,
 		),
 	}
 }
 
-func createCoreInstance(d *schema.ResourceData, m interface{}) error {
-	sync := &CoreInstanceResourceCrud{}
-	sync.D = d
-	sync.Client = m.(*OracleClients).computeClient
-	sync.VirtualNetworkClient = m.(*OracleClients).virtualNetworkClient
-	sync.BlockStorageClient = m.(*OracleClients).blockstorageClient
+// The metadata keys that can only be set when an instance is launched
+var instanceLaunchOnlyMetadataKeys = []string{"ssh_authorized_keys", "user_data"}
 
-	var powerOff = false
-	if powerState, ok := sync.D.GetOkExists("state"); ok {
-		wantedPowerState := oci_core.InstanceLifecycleStateEnum(strings.ToUpper(powerState.(string)))
-		if wantedPowerState == oci_core.InstanceLifecycleStateStopped {
-			powerOff = true
+func instanceLaunchOnlyMetadataChanged(old, new, meta interface{}) bool {
+	oldMetadataMap := objectMapToStringMap(old.(map[string]interface{}))
+	newMetadataMap := objectMapToStringMap(new.(map[string]interface{}))
+	for _, key := range instanceLaunchOnlyMetadataKeys {
+		if oldMetadataMap[key] != newMetadataMap[key] {
+			return true
 		}
 	}
+	return false
+}
 
-	if e := CreateResource(d, sync); e != nil {
-		return e
-	}
-
-	return powerOffIfNeeded(sync.D, sync, powerOff)
+// The compute limits of common shapes, and whether they limit the number of OCPUs or of instances
+var coreInstanceShapeLimits = []struct {
+	ShapePrefix string
+	LimitName   string
+	CountsOcpus bool
+}{
+	{"VM.Standard2.", "standard2-core-count", true},
+	{"VM.Standard.E2.", "standard-e2-core-count", true},
+	{"VM.Standard.E3.Flex", "standard-e3-core-ad-count", true},
+	{"BM.Standard2.52", "bm-standard2-52-count", false},
+	{"BM.Standard.E2.64", "bm-standard-e2-64-count", false},
+	{"BM.DenseIO2.52", "bm-dense-io2-52-count", false},
 }
 
-func powerOffIfNeeded(d *schema.ResourceData, sync *CoreInstanceResourceCrud, powerOff bool) error {
+func checkCoreInstanceServiceLimits(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("shape") || !d.NewValueKnown("availability_domain") {
+		return nil
+	}
+	shape := d.Get("shape").(string)
 
-	if powerOff {
-		if err := sync.InstanceAction(oci_core.InstanceActionActionStop, oci_core.InstanceLifecycleStateStopped); err != nil {
-			return err
+	for _, shapeLimit := range coreInstanceShapeLimits {
+		if !strings.HasPrefix(shape, shapeLimit.ShapePrefix) {
+			continue
 		}
-		return ReadResource(sync)
+
+		amount := int64(1)
+		if shapeLimit.CountsOcpus {
+			if strings.HasSuffix(shape, ".Flex") {
+				ocpus, ok := d.GetOk("shape_config.0.ocpus")
+				if !ok || !d.NewValueKnown("shape_config") {
+					return nil
+				}
+				amount = int64(math.Ceil(ocpus.(float64)))
+			} else {
+				ocpus, err := strconv.ParseInt(shape[strings.LastIndex(shape, ".")+1:], 10, 64)
+				if err != nil {
+					return nil
+				}
+				amount = ocpus
+			}
+		}
+
+		return checkServiceLimits(d, meta, serviceLimitUsage{
+			ServiceName:        "compute",
+			LimitName:          shapeLimit.LimitName,
+			AvailabilityDomain: d.Get("availability_domain").(string),
+			Amount:             amount,
+		})
 	}
 	return nil
 }
 
+func createCoreInstance(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreInstanceResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).computeClient
+	sync.VirtualNetworkClient = m.(*OracleClients).virtualNetworkClient
+	sync.BlockStorageClient = m.(*OracleClients).blockstorageClient
+
+	return CreateResource(d, sync)
+}
+
 func readCoreInstance(d *schema.ResourceData, m interface{}) error {
 	sync := &CoreInstanceResourceCrud{}
 	sync.D = d
@@ -516,35 +559,7 @@ func updateCoreInstance(d *schema.ResourceData, m interface{}) error {
 	sync.BlockStorageClient = m.(*OracleClients).blockstorageClient
 	sync.workRequestClient = m.(*OracleClients).workRequestClient
 
-	// switch to power on
-	powerOn, powerOff := false, false
-
-	if sync.D.HasChange("state") {
-		wantedState := strings.ToUpper(sync.D.Get("state").(string))
-		if oci_core.InstanceLifecycleStateRunning == oci_core.InstanceLifecycleStateEnum(wantedState) {
-			powerOn = true
-		} else if oci_core.InstanceLifecycleStateStopped == oci_core.InstanceLifecycleStateEnum(wantedState) {
-			powerOff = true
-		}
-	}
-
-	if powerOn {
-		if err := sync.InstanceAction(oci_core.InstanceActionActionStart, oci_core.InstanceLifecycleStateRunning); err != nil {
-			return err
-		}
-		sync.D.Set("state", oci_core.InstanceLifecycleStateRunning)
-	}
-	if err := UpdateResource(d, sync); err != nil {
-		return err
-	}
-	// switch to power off
-	if powerOff {
-		if err := sync.InstanceAction(oci_core.InstanceActionActionStop, oci_core.InstanceLifecycleStateStopped); err != nil {
-			return err
-		}
-		sync.D.Set("state", oci_core.InstanceLifecycleStateStopped)
-	}
-	return nil
+	return UpdateResource(d, sync)
 }
 
 func deleteCoreInstance(d *schema.ResourceData, m interface{}) error {
@@ -765,16 +780,6 @@ func (s *CoreInstanceResourceCrud) Get() error {
 }
 
 func (s *CoreInstanceResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
-
 	// update shape and shape config
 	err := s.updateShape()
 
@@ -814,6 +819,9 @@ func (s *CoreInstanceResourceCrud) Update() error {
 			return err
 		}
 		request.ExtendedMetadata = extendedMetadata
+	} else if s.D.HasChange("extended_metadata") {
+		// The update replaces the whole extended metadata, so removing every key has to be sent as an empty map
+		request.ExtendedMetadata = map[string]interface{}{}
 	}
 
 	if freeformTags, ok := s.D.GetOkExists("freeform_tags"); ok {
@@ -825,6 +833,8 @@ func (s *CoreInstanceResourceCrud) Update() error {
 
 	if metadata, ok := s.D.GetOkExists("metadata"); ok {
 		request.Metadata = objectMapToStringMap(metadata.(map[string]interface{}))
+	} else if s.D.HasChange("metadata") {
+		request.Metadata = map[string]string{}
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
@@ -876,7 +886,23 @@ func (s *CoreInstanceResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreInstanceResourceCrud) InstanceAction(action oci_core.InstanceActionActionEnum, state oci_core.InstanceLifecycleStateEnum) error {
+func (s *CoreInstanceResourceCrud) RunningState() string {
+	return string(oci_core.InstanceLifecycleStateRunning)
+}
+
+func (s *CoreInstanceResourceCrud) StoppedState() string {
+	return string(oci_core.InstanceLifecycleStateStopped)
+}
+
+func (s *CoreInstanceResourceCrud) Start() error {
+	return s.InstanceAction(oci_core.InstanceActionActionStart)
+}
+
+func (s *CoreInstanceResourceCrud) Stop() error {
+	return s.InstanceAction(oci_core.InstanceActionActionStop)
+}
+
+func (s *CoreInstanceResourceCrud) InstanceAction(action oci_core.InstanceActionActionEnum) error {
 	request := oci_core.InstanceActionRequest{}
 	request.Action = action
 
@@ -886,13 +912,7 @@ func (s *CoreInstanceResourceCrud) InstanceAction(action oci_core.InstanceAction
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.InstanceAction(context.Background(), request)
-	if err != nil {
-		return err
-	}
-
-	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == state }
-	return WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
-
+	return err
 }
 
 func (s *CoreInstanceResourceCrud) Delete() error {
@@ -1608,7 +1628,7 @@ func (s *CoreInstanceResourceCrud) getBootVolume() (*oci_core.BootVolume, error)
 	return &bootVolumeResponse.BootVolume, nil
 }
 
-func (s *CoreInstanceResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreInstanceResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeInstanceCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1619,10 +1639,17 @@ func (s *CoreInstanceResourceCrud) updateCompartment(compartment interface{}) er
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeInstanceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeInstanceCompartment(context.Background(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
+
+	if workId := response.OpcWorkRequestId; workId != nil {
+		_, err = WaitForWorkRequest(s.workRequestClient, workId, "instance", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, false)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -1669,3 +1696,29 @@ func (s *CoreInstanceResourceCrud) updateShape() error {
 	}
 	return nil
 }
+
+// Instances created with the deprecated image, subnet_id and hostname_label arguments may not have source_details
+// and create_vnic_details in their state. Move them into those blocks, so that changing the configuration to the
+// blocks does not replace the instance.
+func upgradeCoreInstanceStateV0(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if image, ok := rawState["image"].(string); ok && image != "" && isEmptyNestedBlock(rawState["source_details"]) {
+		rawState["source_details"] = []interface{}{
+			map[string]interface{}{
+				"source_id":   image,
+				"source_type": "image",
+			},
+		}
+	}
+
+	if subnetId, ok := rawState["subnet_id"].(string); ok && subnetId != "" && isEmptyNestedBlock(rawState["create_vnic_details"]) {
+		createVnicDetails := map[string]interface{}{
+			"subnet_id": subnetId,
+		}
+		if hostnameLabel, ok := rawState["hostname_label"].(string); ok && hostnameLabel != "" {
+			createVnicDetails["hostname_label"] = hostnameLabel
+		}
+		rawState["create_vnic_details"] = []interface{}{createVnicDetails}
+	}
+
+	return rawState, nil
+}
@@ -1411,3 +1411,23 @@ func TestResourceCoreInstanceTestSuite(t *testing.T) {
 	}
 	suite.Run(t, new(ResourceCoreInstanceTestSuite))
 }
+
+func TestUnitInstanceLaunchOnlyMetadataChanged(t *testing.T) {
+	tests := []struct {
+		old      map[string]interface{}
+		new      map[string]interface{}
+		forceNew bool
+	}{
+		{map[string]interface{}{"user_data": "abcd"}, map[string]interface{}{"user_data": "abcd", "volatile_data": "stringE"}, false},
+		{map[string]interface{}{"user_data": "abcd", "volatile_data": "stringD"}, map[string]interface{}{"user_data": "abcd"}, false},
+		{map[string]interface{}{"user_data": "abcd"}, map[string]interface{}{"user_data": "efgh"}, true},
+		{map[string]interface{}{"user_data": "abcd"}, map[string]interface{}{}, true},
+		{map[string]interface{}{}, map[string]interface{}{"ssh_authorized_keys": "ssh-rsa AAAA"}, true},
+	}
+
+	for _, test := range tests {
+		if result := instanceLaunchOnlyMetadataChanged(test.old, test.new, nil); result != test.forceNew {
+			t.Errorf("expected force new to be %t for %v and %v, got %t", test.forceNew, test.old, test.new, result)
+		}
+	}
+}
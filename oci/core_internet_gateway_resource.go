@@ -206,15 +206,6 @@ func (s *CoreInternetGatewayResourceCrud) Get() error {
 }
 
 func (s *CoreInternetGatewayResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateInternetGatewayRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -297,7 +288,7 @@ func (s *CoreInternetGatewayResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreInternetGatewayResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreInternetGatewayResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeInternetGatewayCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
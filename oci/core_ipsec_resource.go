@@ -257,15 +257,6 @@ func (s *CoreIpSecConnectionResourceCrud) Get() error {
 }
 
 func (s *CoreIpSecConnectionResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateIPSecConnectionRequest{}
 
 	if cpeLocalIdentifier, ok := s.D.GetOkExists("cpe_local_identifier"); ok {
@@ -373,7 +364,7 @@ func (s *CoreIpSecConnectionResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreIpSecConnectionResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreIpSecConnectionResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeIPSecConnectionCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
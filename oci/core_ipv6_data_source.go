@@ -0,0 +1,110 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_core "github.com/oracle/oci-go-sdk/core"
+)
+
+func init() {
+	RegisterDatasource("oci_core_ipv6", CoreIpv6DataSource())
+}
+
+func CoreIpv6DataSource() *schema.Resource {
+	fieldMap := make(map[string]*schema.Schema)
+	fieldMap["ipv6id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	return GetSingularDataSourceItemSchema(CoreIpv6Resource(), fieldMap, readSingularCoreIpv6)
+}
+
+func readSingularCoreIpv6(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreIpv6DataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).virtualNetworkClient
+
+	return ReadResource(sync)
+}
+
+type CoreIpv6DataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_core.VirtualNetworkClient
+	Res    *oci_core.GetIpv6Response
+}
+
+func (s *CoreIpv6DataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *CoreIpv6DataSourceCrud) Get() error {
+	request := oci_core.GetIpv6Request{}
+
+	if ipv6Id, ok := s.D.GetOkExists("ipv6id"); ok {
+		tmp := ipv6Id.(string)
+		request.Ipv6Id = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+
+	response, err := s.Client.GetIpv6(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	return nil
+}
+
+func (s *CoreIpv6DataSourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.SetId(*s.Res.Id)
+
+	if s.Res.CompartmentId != nil {
+		s.D.Set("compartment_id", *s.Res.CompartmentId)
+	}
+
+	if s.Res.DefinedTags != nil {
+		s.D.Set("defined_tags", definedTagsToMap(s.Res.DefinedTags))
+	}
+
+	if s.Res.DisplayName != nil {
+		s.D.Set("display_name", *s.Res.DisplayName)
+	}
+
+	s.D.Set("freeform_tags", s.Res.FreeformTags)
+
+	if s.Res.IpAddress != nil {
+		s.D.Set("ip_address", *s.Res.IpAddress)
+	}
+
+	if s.Res.IsInternetAccessAllowed != nil {
+		s.D.Set("is_internet_access_allowed", *s.Res.IsInternetAccessAllowed)
+	}
+
+	if s.Res.PublicIpAddress != nil {
+		s.D.Set("public_ip_address", *s.Res.PublicIpAddress)
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.SubnetId != nil {
+		s.D.Set("subnet_id", *s.Res.SubnetId)
+	}
+
+	if s.Res.TimeCreated != nil {
+		s.D.Set("time_created", s.Res.TimeCreated.String())
+	}
+
+	if s.Res.VnicId != nil {
+		s.D.Set("vnic_id", *s.Res.VnicId)
+	}
+
+	return nil
+}
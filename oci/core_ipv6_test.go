@@ -0,0 +1,252 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/oracle/oci-go-sdk/common"
+	oci_core "github.com/oracle/oci-go-sdk/core"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+)
+
+var (
+	Ipv6ResourceConfig = Ipv6ResourceDependencies +
+		generateResourceFromRepresentationMap("oci_core_ipv6", "test_ipv6", Optional, Update, ipv6Representation)
+
+	ipv6SingularDataSourceRepresentation = map[string]interface{}{
+		"ipv6id": Representation{repType: Required, create: `${oci_core_ipv6.test_ipv6.id}`},
+	}
+
+	ipv6DataSourceRepresentation = map[string]interface{}{
+		"vnic_id": Representation{repType: Optional, create: `${lookup(data.oci_core_vnic_attachments.t.vnic_attachments[0], "vnic_id")}`},
+		"filter":  RepresentationGroup{Required, ipv6DataSourceFilterRepresentation}}
+	ipv6DataSourceFilterRepresentation = map[string]interface{}{
+		"name":   Representation{repType: Required, create: `id`},
+		"values": Representation{repType: Required, create: []string{`${oci_core_ipv6.test_ipv6.id}`}},
+	}
+
+	ipv6Representation = map[string]interface{}{
+		"vnic_id":                    Representation{repType: Required, create: `${lookup(data.oci_core_vnic_attachments.t.vnic_attachments[0], "vnic_id")}`},
+		"defined_tags":               Representation{repType: Optional, create: `${map("${oci_identity_tag_namespace.tag-namespace1.name}.${oci_identity_tag.tag1.name}", "value")}`, update: `${map("${oci_identity_tag_namespace.tag-namespace1.name}.${oci_identity_tag.tag1.name}", "updatedValue")}`},
+		"display_name":               Representation{repType: Optional, create: `displayName`, update: `displayName2`},
+		"freeform_tags":              Representation{repType: Optional, create: map[string]string{"Department": "Finance"}, update: map[string]string{"Department": "Accounting"}},
+		"ip_address":                 Representation{repType: Optional, create: `fd00:aaaa:123:0:0:0:0:5`},
+		"is_internet_access_allowed": Representation{repType: Optional, create: `false`, update: `true`},
+	}
+
+	Ipv6ResourceDependencies = OciImageIdsVariable +
+		generateResourceFromRepresentationMap("oci_core_instance", "test_instance", Required, Create, instanceRepresentation) +
+		generateResourceFromRepresentationMap("oci_core_subnet", "test_subnet", Required, Create, representationCopyWithNewProperties(subnetRepresentation, map[string]interface{}{
+			"dns_label":      Representation{repType: Required, create: `dnslabel`},
+			"ipv6cidr_block": Representation{repType: Required, create: `fd00:aaaa:0123::/64`},
+		})) +
+		generateResourceFromRepresentationMap("oci_core_vcn", "test_vcn", Required, Create, representationCopyWithNewProperties(vcnRepresentation, map[string]interface{}{
+			"dns_label":      Representation{repType: Required, create: `dnslabel`},
+			"ipv6cidr_block": Representation{repType: Required, create: `fd00:aaaa:0123::/48`},
+			"is_ipv6enabled": Representation{repType: Required, create: `true`},
+		})) +
+		AvailabilityDomainConfig +
+		DefinedTagsDependencies + `
+	data "oci_core_vnic_attachments" "t" {
+		availability_domain = "${data.oci_identity_availability_domains.test_availability_domains.availability_domains.0.name}"
+		compartment_id = "${var.compartment_id}"
+		instance_id = "${oci_core_instance.test_instance.id}"
+	}
+
+`
+)
+
+func TestCoreIpv6Resource_basic(t *testing.T) {
+	if !strings.Contains(getEnvSettingWithBlankDefault("enabled_tests"), "IPv6") {
+		t.Skip("IPv6 test not supported in this realm")
+	}
+	httpreplay.SetScenario("TestCoreIpv6Resource_basic")
+	defer httpreplay.SaveScenario()
+
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_core_ipv6.test_ipv6"
+	datasourceName := "data.oci_core_ipv6s.test_ipv6s"
+	singularDatasourceName := "data.oci_core_ipv6.test_ipv6"
+
+	var resId, resId2 string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		CheckDestroy: testAccCheckCoreIpv6Destroy,
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config + compartmentIdVariableStr + Ipv6ResourceDependencies +
+					generateResourceFromRepresentationMap("oci_core_ipv6", "test_ipv6", Required, Create, ipv6Representation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ip_address"),
+					resource.TestCheckResourceAttrSet(resourceName, "vnic_id"),
+
+					func(s *terraform.State) (err error) {
+						resId, err = fromInstanceState(s, resourceName, "id")
+						return err
+					},
+				),
+			},
+
+			// delete before next create
+			{
+				Config: config + compartmentIdVariableStr + Ipv6ResourceDependencies,
+			},
+			// verify create with optionals
+			{
+				Config: config + compartmentIdVariableStr + Ipv6ResourceDependencies +
+					generateResourceFromRepresentationMap("oci_core_ipv6", "test_ipv6", Optional, Create, ipv6Representation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+					resource.TestCheckResourceAttr(resourceName, "defined_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "displayName"),
+					resource.TestCheckResourceAttr(resourceName, "freeform_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ip_address", "fd00:aaaa:123:0:0:0:0:5"),
+					resource.TestCheckResourceAttr(resourceName, "is_internet_access_allowed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "public_ip_address", ""),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+					resource.TestCheckResourceAttrSet(resourceName, "subnet_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "vnic_id"),
+
+					func(s *terraform.State) (err error) {
+						resId, err = fromInstanceState(s, resourceName, "id")
+						return err
+					},
+				),
+			},
+
+			// verify updates to updatable parameters
+			{
+				Config: config + compartmentIdVariableStr + Ipv6ResourceDependencies +
+					generateResourceFromRepresentationMap("oci_core_ipv6", "test_ipv6", Optional, Update, ipv6Representation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "defined_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "displayName2"),
+					resource.TestCheckResourceAttr(resourceName, "freeform_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ip_address", "fd00:aaaa:123:0:0:0:0:5"),
+					resource.TestCheckResourceAttr(resourceName, "is_internet_access_allowed", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "public_ip_address"),
+					resource.TestCheckResourceAttrSet(resourceName, "vnic_id"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
+			// verify datasource
+			{
+				Config: config +
+					generateDataSourceFromRepresentationMap("oci_core_ipv6s", "test_ipv6s", Optional, Update, ipv6DataSourceRepresentation) +
+					compartmentIdVariableStr + Ipv6ResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "vnic_id"),
+
+					resource.TestCheckResourceAttr(datasourceName, "ipv6s.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "ipv6s.0.compartment_id", compartmentId),
+					resource.TestCheckResourceAttr(datasourceName, "ipv6s.0.defined_tags.%", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "ipv6s.0.display_name", "displayName2"),
+					resource.TestCheckResourceAttr(datasourceName, "ipv6s.0.freeform_tags.%", "1"),
+					resource.TestCheckResourceAttrSet(datasourceName, "ipv6s.0.id"),
+					resource.TestCheckResourceAttr(datasourceName, "ipv6s.0.ip_address", "fd00:aaaa:123:0:0:0:0:5"),
+					resource.TestCheckResourceAttr(datasourceName, "ipv6s.0.is_internet_access_allowed", "true"),
+					resource.TestCheckResourceAttrSet(datasourceName, "ipv6s.0.state"),
+					resource.TestCheckResourceAttrSet(datasourceName, "ipv6s.0.subnet_id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "ipv6s.0.time_created"),
+					resource.TestCheckResourceAttrSet(datasourceName, "ipv6s.0.vnic_id"),
+				),
+			},
+			// verify singular datasource
+			{
+				Config: config +
+					generateDataSourceFromRepresentationMap("oci_core_ipv6", "test_ipv6", Required, Create, ipv6SingularDataSourceRepresentation) +
+					compartmentIdVariableStr + Ipv6ResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "ipv6id"),
+
+					resource.TestCheckResourceAttr(singularDatasourceName, "compartment_id", compartmentId),
+					resource.TestCheckResourceAttr(singularDatasourceName, "defined_tags.%", "1"),
+					resource.TestCheckResourceAttr(singularDatasourceName, "display_name", "displayName2"),
+					resource.TestCheckResourceAttr(singularDatasourceName, "freeform_tags.%", "1"),
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "id"),
+					resource.TestCheckResourceAttr(singularDatasourceName, "ip_address", "fd00:aaaa:123:0:0:0:0:5"),
+					resource.TestCheckResourceAttr(singularDatasourceName, "is_internet_access_allowed", "true"),
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "public_ip_address"),
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "state"),
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "time_created"),
+				),
+			},
+			// remove singular datasource from previous step so that it doesn't conflict with import tests
+			{
+				Config: config + compartmentIdVariableStr + Ipv6ResourceConfig,
+			},
+			// verify resource import
+			{
+				Config:                  config,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+				ResourceName:            resourceName,
+			},
+		},
+	})
+}
+
+func testAccCheckCoreIpv6Destroy(s *terraform.State) error {
+	noResourceFound := true
+	client := testAccProvider.Meta().(*OracleClients).virtualNetworkClient
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type == "oci_core_ipv6" {
+			noResourceFound = false
+			request := oci_core.GetIpv6Request{}
+
+			tmp := rs.Primary.ID
+			request.Ipv6Id = &tmp
+
+			request.RequestMetadata.RetryPolicy = getRetryPolicy(true, "core")
+
+			response, err := client.GetIpv6(context.Background(), request)
+
+			if err == nil {
+				deletedLifecycleStates := map[string]bool{
+					string(oci_core.Ipv6LifecycleStateTerminated): true,
+				}
+				if _, ok := deletedLifecycleStates[string(response.LifecycleState)]; !ok {
+					//resource lifecycle state is not in expected deleted lifecycle states.
+					return fmt.Errorf("resource lifecycle state: %s is not in expected deleted lifecycle states", response.LifecycleState)
+				}
+				//resource lifecycle state is in expected deleted lifecycle states. continue with next one.
+				continue
+			}
+
+			//Verify that exception is for '404 not found'.
+			if failure, isServiceError := common.IsServiceError(err); !isServiceError || failure.GetHTTPStatusCode() != 404 {
+				return err
+			}
+		}
+	}
+	if noResourceFound {
+		return fmt.Errorf("at least one resource was expected from the state file, but could not be found")
+	}
+
+	return nil
+}
@@ -292,15 +292,6 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Get() error {
 }
 
 func (s *CoreLocalPeeringGatewayResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateLocalPeeringGatewayRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -399,7 +390,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreLocalPeeringGatewayResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreLocalPeeringGatewayResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeLocalPeeringGatewayCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -210,15 +210,6 @@ func (s *CoreNatGatewayResourceCrud) Get() error {
 }
 
 func (s *CoreNatGatewayResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateNatGatewayRequest{}
 
 	if blockTraffic, ok := s.D.GetOkExists("block_traffic"); ok {
@@ -305,7 +296,7 @@ func (s *CoreNatGatewayResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreNatGatewayResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreNatGatewayResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeNatGatewayCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -196,15 +196,6 @@ func (s *CoreNetworkSecurityGroupResourceCrud) Get() error {
 }
 
 func (s *CoreNetworkSecurityGroupResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateNetworkSecurityGroupRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -278,7 +269,7 @@ func (s *CoreNetworkSecurityGroupResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreNetworkSecurityGroupResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreNetworkSecurityGroupResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeNetworkSecurityGroupCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -46,9 +46,11 @@ func CoreNetworkSecurityGroupSecurityRuleResource() *schema.Resource {
 				Computed: true,
 			},
 			"destination": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateCidrNetworkOrName,
+				DiffSuppressFunc: cidrNetworkDiffSuppressFunction,
 			},
 			"destination_type": {
 				Type:     schema.TypeString,
@@ -80,8 +82,10 @@ func CoreNetworkSecurityGroupSecurityRuleResource() *schema.Resource {
 				},
 			},
 			"source": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateCidrNetworkOrName,
+				DiffSuppressFunc: cidrNetworkDiffSuppressFunction,
 			},
 			"source_type": {
 				Type:     schema.TypeString,
@@ -243,15 +243,6 @@ func (s *CorePublicIpResourceCrud) Get() error {
 }
 
 func (s *CorePublicIpResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdatePublicIpRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -338,8 +329,12 @@ func (s *CorePublicIpResourceCrud) SetData() error {
 
 	s.D.Set("lifetime", s.Res.Lifetime)
 
+	// A reserved public IP is unassigned by the service when its private IP is deleted, for example when
+	// the VNIC is recreated. Clear the stale assignment so that the next plan reassigns the reservation.
 	if s.Res.PrivateIpId != nil {
 		s.D.Set("private_ip_id", *s.Res.PrivateIpId)
+	} else {
+		s.D.Set("private_ip_id", "")
 	}
 
 	s.D.Set("scope", s.Res.Scope)
@@ -353,7 +348,7 @@ func (s *CorePublicIpResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CorePublicIpResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CorePublicIpResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangePublicIpCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -289,15 +289,6 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Get() error {
 }
 
 func (s *CoreRemotePeeringConnectionResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateRemotePeeringConnectionRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -412,7 +403,7 @@ func getRemotePeeringConnectionRetryPolicy(timeout time.Duration) *oci_common.Re
 	}
 }
 
-func (s *CoreRemotePeeringConnectionResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreRemotePeeringConnectionResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeRemotePeeringConnectionCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -76,10 +76,11 @@ func CoreRouteTableResource() *schema.Resource {
 
 						// Optional
 						"cidr_block": {
-							Type:       schema.TypeString,
-							Optional:   true,
-							Computed:   true,
-							Deprecated: FieldDeprecatedForAnother("cidr_block", "destination"),
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateCidrBlockOrName,
+							Deprecated:   FieldDeprecatedForAnother("cidr_block", "destination"),
 						},
 						"description": {
 							Type:     schema.TypeString,
@@ -87,9 +88,10 @@ func CoreRouteTableResource() *schema.Resource {
 							Computed: true,
 						},
 						"destination": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateCidrBlockOrName,
 						},
 						"destination_type": {
 							Type:     schema.TypeString,
@@ -259,15 +261,6 @@ func (s *CoreRouteTableResourceCrud) Get() error {
 }
 
 func (s *CoreRouteTableResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateRouteTableRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -448,17 +441,17 @@ func routeRulesHashCodeForSets(v interface{}) int {
 	cidrBlock, cidrBlockPresent := m["cidr_block"]
 	destination, destinationPresent := m["destination"]
 	if cidrBlockPresent && cidrBlock != "" {
-		buf.WriteString(fmt.Sprintf("%v-", cidrBlock))
+		buf.WriteString(fmt.Sprintf("%v-", canonicalCidrBlock(cidrBlock.(string))))
 	} else if destinationPresent && destination != "" {
-		buf.WriteString(fmt.Sprintf("%v-", destination))
+		buf.WriteString(fmt.Sprintf("%v-", canonicalCidrBlock(destination.(string))))
 	}
 	if description, ok := m["description"]; ok && description != "" {
 		buf.WriteString(fmt.Sprintf("%v-", description))
 	}
 	if destinationPresent && destination != "" {
-		buf.WriteString(fmt.Sprintf("%v-", destination))
+		buf.WriteString(fmt.Sprintf("%v-", canonicalCidrBlock(destination.(string))))
 	} else if cidrBlockPresent && cidrBlock != "" {
-		buf.WriteString(fmt.Sprintf("%v-", cidrBlock))
+		buf.WriteString(fmt.Sprintf("%v-", canonicalCidrBlock(cidrBlock.(string))))
 	}
 	if destinationType, ok := m["destination_type"]; ok && destinationType != "" {
 		buf.WriteString(fmt.Sprintf("%v-", destinationType))
@@ -471,7 +464,7 @@ func routeRulesHashCodeForSets(v interface{}) int {
 	}
 	return hashcode.String(buf.String())
 }
-func (s *CoreRouteTableResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreRouteTableResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeRouteTableCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
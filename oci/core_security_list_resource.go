@@ -63,8 +63,10 @@ func CoreSecurityListResource() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						// Required
 						"destination": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validateCidrNetworkOrName,
+							DiffSuppressFunc: cidrNetworkDiffSuppressFunction,
 						},
 						"protocol": {
 							Type:     schema.TypeString,
@@ -234,8 +236,10 @@ func CoreSecurityListResource() *schema.Resource {
 							Required: true,
 						},
 						"source": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validateCidrNetworkOrName,
+							DiffSuppressFunc: cidrNetworkDiffSuppressFunction,
 						},
 
 						// Optional
@@ -558,15 +562,6 @@ func (s *CoreSecurityListResourceCrud) Get() error {
 }
 
 func (s *CoreSecurityListResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateSecurityListRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -1049,7 +1044,7 @@ func egressSecurityRulesHashCodeForSets(v interface{}) int {
 		buf.WriteString(fmt.Sprintf("%v-", description))
 	}
 	if destination, ok := m["destination"]; ok && destination != "" {
-		buf.WriteString(fmt.Sprintf("%v-", destination))
+		buf.WriteString(fmt.Sprintf("%v-", canonicalCidrNetwork(destination.(string))))
 	}
 	if destinationType, ok := m["destination_type"]; ok && destinationType != "" {
 		buf.WriteString(fmt.Sprintf("%v-", destinationType))
@@ -1151,7 +1146,7 @@ func ingressSecurityRulesHashCodeForSets(v interface{}) int {
 		buf.WriteString(fmt.Sprintf("%v-", protocol))
 	}
 	if source, ok := m["source"]; ok && source != "" {
-		buf.WriteString(fmt.Sprintf("%v-", source))
+		buf.WriteString(fmt.Sprintf("%v-", canonicalCidrNetwork(source.(string))))
 	}
 	if sourceType, ok := m["source_type"]; ok && sourceType != "" {
 		buf.WriteString(fmt.Sprintf("%v-", sourceType))
@@ -1214,7 +1209,7 @@ func ingressSecurityRulesHashCodeForSets(v interface{}) int {
 	}
 	return hashcode.String(buf.String())
 }
-func (s *CoreSecurityListResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreSecurityListResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeSecurityListCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -253,15 +253,6 @@ func (s *CoreServiceGatewayResourceCrud) Get() error {
 }
 
 func (s *CoreServiceGatewayResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateServiceGatewayRequest{}
 
 	if blockTraffic, ok := s.D.GetOkExists("block_traffic"); ok {
@@ -410,7 +401,7 @@ func servicesHashCodeForSets(v interface{}) int {
 	}
 	return hashcode.String(buf.String())
 }
-func (s *CoreServiceGatewayResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreServiceGatewayResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeServiceGatewayCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
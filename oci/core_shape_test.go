@@ -89,6 +89,48 @@ func TestCoreShapeResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(datasourceName, "shapes.0.processor_description"),
 				),
 			},
+			// verify datasource picks the smallest shape meeting the minimum resources
+			{
+				Config: config +
+					generateDataSourceFromRepresentationMap("oci_core_shapes", "test_shapes", Required, Create, representationCopyWithNewProperties(shapeDataSourceRepresentation, map[string]interface{}{
+						"min_ocpus":         Representation{repType: Required, create: `2`},
+						"min_memory_in_gbs": Representation{repType: Required, create: `16`},
+						"sort_by":           Representation{repType: Required, create: `ocpus`},
+						"limit":             Representation{repType: Required, create: `1`},
+					})) +
+					compartmentIdVariableStr + ShapeResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "compartment_id", compartmentId),
+
+					resource.TestCheckResourceAttr(datasourceName, "shapes.#", "1"),
+					resource.TestCheckResourceAttrSet(datasourceName, "shapes.0.name"),
+					resource.TestCheckResourceAttrSet(datasourceName, "shapes.0.ocpus"),
+				),
+			},
 		},
 	})
 }
+
+func TestUnitShapeResourceAtLeast(t *testing.T) {
+	float32Ptr := func(v float32) *float32 { return &v }
+
+	tests := []struct {
+		value      *float32
+		optionsMax *float32
+		minimum    float64
+		expected   bool
+	}{
+		{float32Ptr(2), nil, 2, true},
+		{float32Ptr(1), nil, 2, false},
+		{nil, nil, 1, false},
+		{float32Ptr(1), float32Ptr(64), 8, true},
+		{float32Ptr(1), float32Ptr(64), 128, false},
+		{nil, float32Ptr(1024), 512, true},
+	}
+
+	for i, test := range tests {
+		if actual := shapeResourceAtLeast(test.value, test.optionsMax, test.minimum); actual != test.expected {
+			t.Errorf("test %d: expected %v but got %v", i, test.expected, actual)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -30,6 +31,23 @@ func CoreShapesDataSource() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"min_gpus": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"min_memory_in_gbs": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"min_networking_bandwidth_in_gbps": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"min_ocpus": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
 			"shapes": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -263,6 +281,10 @@ func (s *CoreShapesDataSourceCrud) SetData() error {
 	resources := []map[string]interface{}{}
 
 	for _, r := range s.Res.Items {
+		if !s.meetsMinimums(r) {
+			continue
+		}
+
 		shape := map[string]interface{}{}
 
 		if r.GpuDescription != nil {
@@ -347,6 +369,61 @@ func (s *CoreShapesDataSourceCrud) SetData() error {
 	return nil
 }
 
+// meetsMinimums checks the shape against the min_* arguments. A flexible shape meets a minimum when it can be
+// configured with enough resources, so its OCPU, memory and bandwidth options are checked as well.
+func (s *CoreShapesDataSourceCrud) meetsMinimums(shape oci_core.Shape) bool {
+	if minOcpus, ok := s.D.GetOkExists("min_ocpus"); ok {
+		var optionsMax *float32
+		if shape.OcpuOptions != nil {
+			optionsMax = shape.OcpuOptions.Max
+		}
+		if !shapeResourceAtLeast(shape.Ocpus, optionsMax, minOcpus.(float64)) {
+			return false
+		}
+	}
+
+	if minMemoryInGBs, ok := s.D.GetOkExists("min_memory_in_gbs"); ok {
+		var optionsMax *float32
+		if shape.MemoryOptions != nil {
+			optionsMax = shape.MemoryOptions.MaxInGBs
+		}
+		if !shapeResourceAtLeast(shape.MemoryInGBs, optionsMax, minMemoryInGBs.(float64)) {
+			return false
+		}
+	}
+
+	if minNetworkingBandwidthInGbps, ok := s.D.GetOkExists("min_networking_bandwidth_in_gbps"); ok {
+		var optionsMax *float32
+		if shape.NetworkingBandwidthOptions != nil {
+			optionsMax = shape.NetworkingBandwidthOptions.MaxInGbps
+		}
+		if !shapeResourceAtLeast(shape.NetworkingBandwidthInGbps, optionsMax, minNetworkingBandwidthInGbps.(float64)) {
+			return false
+		}
+	}
+
+	if minGpus, ok := s.D.GetOkExists("min_gpus"); ok {
+		gpus := 0
+		if shape.Gpus != nil {
+			gpus = *shape.Gpus
+		}
+		if gpus < minGpus.(int) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// shapeResourceAtLeast reports whether the fixed value of a shape resource, or the maximum it can be configured
+// with, is at least the given minimum
+func shapeResourceAtLeast(value *float32, optionsMax *float32, minimum float64) bool {
+	if value != nil && float64(*value) >= minimum {
+		return true
+	}
+	return optionsMax != nil && float64(*optionsMax) >= minimum
+}
+
 func ShapeMaxVnicAttachmentOptionsToMap(obj *oci_core.ShapeMaxVnicAttachmentOptions) map[string]interface{} {
 	result := map[string]interface{}{}
 
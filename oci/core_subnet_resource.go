@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 
 	oci_core "github.com/oracle/oci-go-sdk/core"
+	oci_work_requests "github.com/oracle/oci-go-sdk/workrequests"
 )
 
 func init() {
@@ -27,9 +28,11 @@ func CoreSubnetResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"cidr_block": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateCidrBlock,
+				DiffSuppressFunc: cidrBlockDiffSuppressFunction,
 			},
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -84,7 +87,8 @@ func CoreSubnetResource() *schema.Resource {
 				Optional:         true,
 				Computed:         true,
 				ForceNew:         true,
-				DiffSuppressFunc: ipv6CompressionDiffSuppressFunction,
+				ValidateFunc:     validateCidrBlock,
+				DiffSuppressFunc: cidrBlockDiffSuppressFunction,
 			},
 			"prohibit_public_ip_on_vnic": {
 				Type:     schema.TypeBool,
@@ -162,6 +166,7 @@ func updateCoreSubnet(d *schema.ResourceData, m interface{}) error {
 	sync := &CoreSubnetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
+	sync.workRequestClient = m.(*OracleClients).workRequestClient
 
 	return UpdateResource(d, sync)
 }
@@ -178,6 +183,7 @@ func deleteCoreSubnet(d *schema.ResourceData, m interface{}) error {
 type CoreSubnetResourceCrud struct {
 	BaseCrud
 	Client                 *oci_core.VirtualNetworkClient
+	workRequestClient      *oci_work_requests.WorkRequestClient
 	Res                    *oci_core.Subnet
 	DisableNotFoundRetries bool
 }
@@ -318,15 +324,6 @@ func (s *CoreSubnetResourceCrud) Get() error {
 }
 
 func (s *CoreSubnetResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateSubnetRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -478,7 +475,7 @@ func (s *CoreSubnetResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreSubnetResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreSubnetResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeSubnetCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -489,9 +486,16 @@ func (s *CoreSubnetResourceCrud) updateCompartment(compartment interface{}) erro
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeSubnetCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeSubnetCompartment(context.Background(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
+
+	if workId := response.OpcWorkRequestId; workId != nil {
+		_, err = WaitForWorkRequest(s.workRequestClient, workId, "subnet", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, false)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
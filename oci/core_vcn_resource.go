@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 
 	oci_core "github.com/oracle/oci-go-sdk/core"
+	oci_work_requests "github.com/oracle/oci-go-sdk/workrequests"
 )
 
 func init() {
@@ -27,9 +28,11 @@ func CoreVcnResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"cidr_block": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateCidrBlock,
+				DiffSuppressFunc: cidrBlockDiffSuppressFunction,
 			},
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -67,7 +70,8 @@ func CoreVcnResource() *schema.Resource {
 				Optional:         true,
 				Computed:         true,
 				ForceNew:         true,
-				DiffSuppressFunc: ipv6CompressionDiffSuppressFunction,
+				ValidateFunc:     validateCidrBlock,
+				DiffSuppressFunc: cidrBlockDiffSuppressFunction,
 			},
 			"is_ipv6enabled": {
 				Type:     schema.TypeBool,
@@ -129,6 +133,7 @@ func updateCoreVcn(d *schema.ResourceData, m interface{}) error {
 	sync := &CoreVcnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
+	sync.workRequestClient = m.(*OracleClients).workRequestClient
 
 	return UpdateResource(d, sync)
 }
@@ -145,6 +150,7 @@ func deleteCoreVcn(d *schema.ResourceData, m interface{}) error {
 type CoreVcnResourceCrud struct {
 	BaseCrud
 	Client                 *oci_core.VirtualNetworkClient
+	workRequestClient      *oci_work_requests.WorkRequestClient
 	Res                    *oci_core.Vcn
 	DisableNotFoundRetries bool
 }
@@ -251,15 +257,6 @@ func (s *CoreVcnResourceCrud) Get() error {
 }
 
 func (s *CoreVcnResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateVcnRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -361,7 +358,7 @@ func (s *CoreVcnResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreVcnResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreVcnResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeVcnCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -372,9 +369,16 @@ func (s *CoreVcnResourceCrud) updateCompartment(compartment interface{}) error {
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVcnCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeVcnCompartment(context.Background(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
+
+	if workId := response.OpcWorkRequestId; workId != nil {
+		_, err = WaitForWorkRequest(s.workRequestClient, workId, "vcn", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, false)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
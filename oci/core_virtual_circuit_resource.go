@@ -75,7 +75,7 @@ func CoreVirtualCircuitResource() *schema.Resource {
 							Type:             schema.TypeString,
 							Optional:         true,
 							Computed:         true,
-							DiffSuppressFunc: ipv6CompressionDiffSuppressFunction,
+							DiffSuppressFunc: cidrBlockDiffSuppressFunction,
 						},
 						"oracle_bgp_peering_ip": {
 							Type:     schema.TypeString,
@@ -86,7 +86,7 @@ func CoreVirtualCircuitResource() *schema.Resource {
 							Type:             schema.TypeString,
 							Optional:         true,
 							Computed:         true,
-							DiffSuppressFunc: ipv6CompressionDiffSuppressFunction,
+							DiffSuppressFunc: cidrBlockDiffSuppressFunction,
 						},
 						"vlan": {
 							Type:     schema.TypeInt,
@@ -455,20 +455,6 @@ func (s *CoreVirtualCircuitResourceCrud) Update() error {
 			return fmt.Errorf("unable to update 'public_prefixes', error: %v", err)
 		}
 	}
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-
-			if waitErr := waitForUpdatedState(s.D, s); waitErr != nil {
-				return waitErr
-			}
-		}
-	}
-
 	request := oci_core.UpdateVirtualCircuitRequest{}
 
 	if bandwidthShapeName, ok := s.D.GetOkExists("bandwidth_shape_name"); ok {
@@ -853,7 +839,7 @@ func publicPrefixesHashCodeForSets(v interface{}) int {
 	}
 	return hashcode.String(buf.String())
 }
-func (s *CoreVirtualCircuitResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreVirtualCircuitResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeVirtualCircuitCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -868,5 +854,6 @@ func (s *CoreVirtualCircuitResourceCrud) updateCompartment(compartment interface
 	if err != nil {
 		return err
 	}
-	return nil
+
+	return waitForUpdatedState(s.D, s)
 }
@@ -166,7 +166,7 @@ func createCoreVolumeBackup(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if ok && compartment != *sync.Res.CompartmentId {
-		err = sync.updateCompartment(compartment)
+		err = sync.ChangeCompartment(compartment)
 		if err != nil {
 			return err
 		}
@@ -306,6 +306,8 @@ func (s *CoreVolumeBackupResourceCrud) createVolumeBackupCopy() error {
 		copyVolumeBackupRequest.DisplayName = &tmp
 	}
 
+	copyVolumeBackupRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
 	response, err := s.SourceRegionClient.CopyVolumeBackup(context.Background(), copyVolumeBackupRequest)
 	if err != nil {
 		return err
@@ -373,15 +375,6 @@ func (s *CoreVolumeBackupResourceCrud) Get() error {
 }
 
 func (s *CoreVolumeBackupResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateVolumeBackupRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -495,7 +488,7 @@ func (s *CoreVolumeBackupResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreVolumeBackupResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreVolumeBackupResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeVolumeBackupCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -119,7 +119,7 @@ func createCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 	if ok && compartment != *sync.Res.CompartmentId {
-		err = sync.updateCompartment(compartment)
+		err = sync.ChangeCompartment(compartment)
 		if err != nil {
 			return err
 		}
@@ -262,15 +262,6 @@ func (s *CoreVolumeGroupBackupResourceCrud) Get() error {
 }
 
 func (s *CoreVolumeGroupBackupResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateVolumeGroupBackupRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -368,7 +359,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreVolumeGroupBackupResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreVolumeGroupBackupResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeVolumeGroupBackupCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
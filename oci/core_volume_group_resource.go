@@ -280,15 +280,6 @@ func (s *CoreVolumeGroupResourceCrud) Get() error {
 }
 
 func (s *CoreVolumeGroupResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateVolumeGroupRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -481,7 +472,7 @@ func VolumeGroupSourceDetailsToMap(obj *oci_core.VolumeGroupSourceDetails, datas
 	return result
 }
 
-func (s *CoreVolumeGroupResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreVolumeGroupResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeVolumeGroupCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
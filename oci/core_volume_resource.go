@@ -158,9 +158,44 @@ func CoreVolumeResource() *schema.Resource {
 				Computed: true,
 			},
 		},
+		CustomizeDiff: checkCoreVolumeServiceLimits,
 	}
 }
 
+// Volumes created without a size or a source are 1 TB
+const defaultCoreVolumeSizeInGBs = 1024
+
+func checkCoreVolumeServiceLimits(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("availability_domain") {
+		return nil
+	}
+
+	var sizeInGBs int64 = defaultCoreVolumeSizeInGBs
+	if sizeInGBsStr, ok := d.GetOk("size_in_gbs"); ok && d.NewValueKnown("size_in_gbs") {
+		tmp, err := strconv.ParseInt(sizeInGBsStr.(string), 10, 64)
+		if err != nil {
+			return nil
+		}
+		sizeInGBs = tmp
+	} else if sizeInMBsStr, ok := d.GetOk("size_in_mbs"); ok && d.NewValueKnown("size_in_mbs") {
+		tmp, err := strconv.ParseInt(sizeInMBsStr.(string), 10, 64)
+		if err != nil {
+			return nil
+		}
+		sizeInGBs = (tmp + 1023) / 1024
+	} else if _, ok := d.GetOk("source_details.0.type"); ok {
+		// The size of volumes created from another volume or a backup is not known until they are created
+		return nil
+	}
+
+	return checkServiceLimits(d, meta, serviceLimitUsage{
+		ServiceName:        "block-storage",
+		LimitName:          "total-storage-gb",
+		AvailabilityDomain: d.Get("availability_domain").(string),
+		Amount:             sizeInGBs,
+	})
+}
+
 func createCoreVolume(d *schema.ResourceData, m interface{}) error {
 	sync := &CoreVolumeResourceCrud{}
 	sync.D = d
@@ -359,15 +394,6 @@ func (s *CoreVolumeResourceCrud) Get() error {
 }
 
 func (s *CoreVolumeResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_core.UpdateVolumeRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -575,7 +601,7 @@ func VolumeSourceDetailsToMap(obj *oci_core.VolumeSourceDetails) map[string]inte
 	return result
 }
 
-func (s *CoreVolumeResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreVolumeResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeVolumeCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
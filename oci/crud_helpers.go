@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"math"
 	"reflect"
 	"strconv"
 	"strings"
@@ -41,6 +42,13 @@ var (
 		Update: &FifteenMinutes,
 		Delete: &FifteenMinutes,
 	}
+
+	// How long an eventually consistent resource may not be found after it is created
+	defaultEventualConsistencyWindow = 2 * time.Minute
+	eventualConsistencyWindow        = defaultEventualConsistencyWindow
+
+	// Whether deletion_protection is enabled in the provider
+	deletionProtection = false
 )
 
 const (
@@ -188,7 +196,7 @@ func LoadBalancerResourceGet(client *oci_load_balancer.LoadBalancerClient, d *sc
 				return "", false, nil
 			}
 			if wr.LifecycleState == oci_load_balancer.WorkRequestLifecycleStateFailed {
-				return "", false, fmt.Errorf("WorkRequest FAILED: %+v", wr.ErrorDetails)
+				return "", false, loadBalancerWorkRequestError(wr, updatedWorkRes.OpcRequestId)
 			}
 		}
 		return "", true, nil
@@ -196,7 +204,8 @@ func LoadBalancerResourceGet(client *oci_load_balancer.LoadBalancerClient, d *sc
 	return id, false, nil
 }
 
-func LoadBalancerWaitForWorkRequest(client *oci_load_balancer.LoadBalancerClient, d *schema.ResourceData, wr *oci_load_balancer.WorkRequest, retryPolicy *oci_common.RetryPolicy) error {
+func LoadBalancerWaitForWorkRequest(client *oci_load_balancer.LoadBalancerClient, d *schema.ResourceData, wr *oci_load_balancer.WorkRequest, retryPolicy *oci_common.RetryPolicy, timeout time.Duration) error {
+	var opcRequestId *string
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
 			string(oci_load_balancer.WorkRequestLifecycleStateInProgress),
@@ -212,11 +221,14 @@ func LoadBalancerWaitForWorkRequest(client *oci_load_balancer.LoadBalancerClient
 			getWorkRequestRequest.RequestMetadata.RetryPolicy = retryPolicy
 			workRequestResponse, err := client.GetWorkRequest(context.Background(), getWorkRequestRequest)
 			wr = &workRequestResponse.WorkRequest
+			opcRequestId = workRequestResponse.OpcRequestId
 			return wr, string(wr.LifecycleState), err
 		},
-		Timeout: d.Timeout(schema.TimeoutCreate),
+		Timeout: timeout,
 	}
 
+	setPollInterval(stateConf, providerPollInterval)
+
 	// Should not wait when in replay mode
 	if httpreplay.ShouldRetryImmediately() {
 		stateConf.PollInterval = 1
@@ -227,12 +239,35 @@ func LoadBalancerWaitForWorkRequest(client *oci_load_balancer.LoadBalancerClient
 	}
 
 	if wr.LifecycleState == oci_load_balancer.WorkRequestLifecycleStateFailed {
-		return fmt.Errorf("WorkRequest FAILED: %+v", wr.ErrorDetails)
+		return loadBalancerWorkRequestError(wr, opcRequestId)
 	}
 	return nil
 }
 
+// The load balancer and identity work request errors have always started with this prefix
+const workRequestFailedPrefix = "WorkRequest FAILED:"
+
+// The load balancer work request reports its errors and its latest log message itself
+func loadBalancerWorkRequestError(wr *oci_load_balancer.WorkRequest, opcRequestId *string) error {
+	errorMessages := make([]string, 0)
+	for _, errorDetail := range wr.ErrorDetails {
+		errorMessages = append(errorMessages, fmt.Sprintf("%s: %s", errorDetail.ErrorCode, *errorDetail.Message))
+	}
+
+	logs := make([]string, 0)
+	if wr.Message != nil && *wr.Message != "" {
+		logs = append(logs, *wr.Message)
+	}
+
+	action := ""
+	if wr.Type != nil {
+		action = *wr.Type
+	}
+	return fmt.Errorf("%s %v", workRequestFailedPrefix, newWorkRequestError(wr.Id, "loadbalancer", action, opcRequestId, errorMessages, logs))
+}
+
 func IdentityWaitForWorkRequest(client *oci_identity.IdentityClient, d *schema.ResourceData, wr *oci_identity.WorkRequest, retryPolicy *oci_common.RetryPolicy, timeout time.Duration) error {
+	var opcRequestId *string
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
 			string(oci_identity.WorkRequestStatusInProgress),
@@ -250,11 +285,14 @@ func IdentityWaitForWorkRequest(client *oci_identity.IdentityClient, d *schema.R
 			getWorkRequestRequest.RequestMetadata.RetryPolicy = retryPolicy
 			workRequestResponse, err := client.GetWorkRequest(context.Background(), getWorkRequestRequest)
 			wr = &workRequestResponse.WorkRequest
+			opcRequestId = workRequestResponse.OpcRequestId
 			return wr, string(wr.Status), err
 		},
 		Timeout: timeout,
 	}
 
+	setPollInterval(stateConf, providerPollInterval)
+
 	// Should not wait when in replay mode
 	if httpreplay.ShouldRetryImmediately() {
 		stateConf.PollInterval = 1
@@ -265,7 +303,16 @@ func IdentityWaitForWorkRequest(client *oci_identity.IdentityClient, d *schema.R
 	}
 
 	if wr.Status == oci_identity.WorkRequestStatusFailed || wr.Status == oci_identity.WorkRequestStatusCanceled {
-		return fmt.Errorf("WorkRequest FAILED: %+v", wr.Errors)
+		// The identity work request includes its errors and log entries
+		errorMessages := make([]string, 0)
+		for _, wrkErr := range wr.Errors {
+			errorMessages = append(errorMessages, *wrkErr.Message)
+		}
+		logs := make([]string, 0)
+		for _, logEntry := range wr.Logs {
+			logs = append(logs, *logEntry.Message)
+		}
+		return fmt.Errorf("%s %v", workRequestFailedPrefix, newWorkRequestError(wr.Id, "identity", string(wr.OperationType), opcRequestId, errorMessages, logs))
 	}
 	return nil
 }
@@ -319,6 +366,10 @@ func CreateResource(d *schema.ResourceData, sync ResourceCreator) error {
 		}
 	}
 
+	// The state is read before it is overwritten with the lifecycle state of the new resource
+	powerState, isPowerStateResource := sync.(PowerStateResource)
+	stopAfterCreate := isPowerStateResource && strings.EqualFold(d.Get("state").(string), powerState.StoppedState())
+
 	if e := sync.Create(); e != nil {
 		if metrics.ShouldWriteMetrics() {
 			metrics.SaveResourceDurationMetric(getResourceName(sync), "Create", FAILED, elaspedInMillisecond(start))
@@ -358,6 +409,18 @@ func CreateResource(d *schema.ResourceData, sync ResourceCreator) error {
 		return e
 	}
 
+	if stopAfterCreate {
+		if e := setPowerState(d, powerState, powerState.StoppedState()); e != nil {
+			if metrics.ShouldWriteMetrics() {
+				metrics.SaveResourceDurationMetric(getResourceName(sync), "Create", FAILED, elaspedInMillisecond(start))
+			}
+			return e
+		}
+		if e := sync.SetData(); e != nil {
+			return e
+		}
+	}
+
 	if ew, waitOK := sync.(ExtraWaitPostCreateDelete); waitOK {
 		time.Sleep(ew.ExtraWaitPostCreateDelete())
 	}
@@ -371,7 +434,11 @@ func CreateResource(d *schema.ResourceData, sync ResourceCreator) error {
 func ReadResource(sync ResourceReader) error {
 	if e := sync.Get(); e != nil {
 		log.Printf("ERROR IN GET: %v\n", e.Error())
+		getErr := e
 		handleMissingResourceError(sync, &e)
+		if e == nil {
+			log.Printf("[WARN] Resource no longer exists and was removed from the state, it will be recreated on the next apply: %v", getErr)
+		}
 		return e
 	}
 
@@ -383,6 +450,7 @@ func ReadResource(sync ResourceReader) error {
 	if dr, ok := sync.(StatefullyDeletedResource); ok {
 		for _, target := range dr.DeletedTarget() {
 			if dr.State() == target && dr.State() != string(oci_load_balancer.WorkRequestLifecycleStateSucceeded) {
+				log.Printf("[WARN] Resource is in the %s state and was removed from the state, it will be recreated on the next apply", target)
 				dr.VoidState()
 				return nil
 			}
@@ -402,6 +470,32 @@ func UpdateResource(d *schema.ResourceData, sync ResourceUpdater) error {
 	}
 
 	d.Partial(true)
+	if mover, ok := sync.(CompartmentMover); ok {
+		if e := moveCompartment(d, mover); e != nil {
+			if metrics.ShouldWriteMetrics() {
+				metrics.SaveResourceDurationMetric(getResourceName(sync), "Update", FAILED, elaspedInMillisecond(start))
+			}
+
+			return e
+		}
+	}
+
+	// Resources are started before they are updated and stopped after, since some services reject updates of
+	// stopped resources
+	powerState, isPowerStateResource := sync.(PowerStateResource)
+	wantedPowerState := ""
+	if isPowerStateResource && d.HasChange("state") {
+		wantedPowerState = strings.ToUpper(d.Get("state").(string))
+	}
+	if wantedPowerState != "" && wantedPowerState == powerState.RunningState() {
+		if e := setPowerState(d, powerState, wantedPowerState); e != nil {
+			if metrics.ShouldWriteMetrics() {
+				metrics.SaveResourceDurationMetric(getResourceName(sync), "Update", FAILED, elaspedInMillisecond(start))
+			}
+			return e
+		}
+	}
+
 	if e := sync.Update(); e != nil {
 		if metrics.ShouldWriteMetrics() {
 			metrics.SaveResourceDurationMetric(getResourceName(sync), "Update", FAILED, elaspedInMillisecond(start))
@@ -428,17 +522,73 @@ func UpdateResource(d *schema.ResourceData, sync ResourceUpdater) error {
 		return e
 	}
 
+	if wantedPowerState != "" && wantedPowerState == powerState.StoppedState() {
+		if e := setPowerState(d, powerState, wantedPowerState); e != nil {
+			if metrics.ShouldWriteMetrics() {
+				metrics.SaveResourceDurationMetric(getResourceName(sync), "Update", FAILED, elaspedInMillisecond(start))
+			}
+			return e
+		}
+		if e := sync.SetData(); e != nil {
+			return e
+		}
+	}
+
 	if metrics.ShouldWriteMetrics() {
 		metrics.SaveResourceDurationMetric(getResourceName(sync), "Update", SUCCEEDED, elaspedInMillisecond(start))
 	}
 	return nil
 }
 
+// setPowerState starts or stops a resource, unless it is already in the wanted state, and waits for its lifecycle
+// state to reach the wanted state
+func setPowerState(d *schema.ResourceData, sync PowerStateResource, wantedState string) error {
+	if e := sync.Get(); e != nil {
+		return e
+	}
+	if e := sync.setState(sync); e != nil {
+		return e
+	}
+	if sync.State() == wantedState {
+		log.Printf("[DEBUG] resource %s is already in the %s state", d.Id(), wantedState)
+		return nil
+	}
+
+	changePowerState := sync.Start
+	if wantedState == sync.StoppedState() {
+		changePowerState = sync.Stop
+	}
+	if e := changePowerState(); e != nil {
+		return e
+	}
+
+	reachedWantedState := func() bool { return sync.setState(sync) == nil && sync.State() == wantedState }
+	return WaitForResourceCondition(sync, reachedWantedState, d.Timeout(schema.TimeoutUpdate))
+}
+
+// moveCompartment moves a resource to the new compartment when compartment_id has changed, instead of recreating it
+func moveCompartment(d *schema.ResourceData, sync CompartmentMover) error {
+	if !d.HasChange("compartment_id") {
+		return nil
+	}
+	oldRaw, newRaw := d.GetChange("compartment_id")
+	if oldRaw == "" || newRaw == "" {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Moving %s from compartment %s to %s", d.Id(), oldRaw, newRaw)
+	return sync.ChangeCompartment(newRaw)
+}
+
 // DeleteResource requests a Delete(). If the resource deletes
 // statefully (not immediately), poll State to ensure:
 // () -> Pending -> Deleted.
 // Finally, sets the ResourceData state to empty.
 func DeleteResource(d *schema.ResourceData, sync ResourceDeleter) error {
+	if protectedResource, ok := sync.(DeletionProtectedResource); ok && deletionProtection && protectedResource.IsDeletionProtected() {
+		return fmt.Errorf("%s is protected from deletion by %s in the provider, set it to false to delete the resource", d.Id(), deletionProtectionAttrName)
+	}
+
 	start := time.Now()
 	if synchronizedResource, ok := sync.(SynchronizedResource); ok {
 		if mutex := synchronizedResource.GetMutex(); mutex != nil {
@@ -510,6 +660,21 @@ func stateRefreshFunc(sync StatefulResource) resource.StateRefreshFunc {
 	}
 }
 
+// eventuallyConsistentStateRefreshFunc wraps stateRefreshFunc so that a resource that can not be found yet is reported
+// as missing instead of failing the wait, until the window has passed.
+func eventuallyConsistentStateRefreshFunc(sync StatefulResource, window time.Duration) resource.StateRefreshFunc {
+	refresh := stateRefreshFunc(sync)
+	startTime := time.Now()
+	return func() (interface{}, string, error) {
+		res, s, e := refresh()
+		if e != nil && strings.Contains(strings.ToLower(e.Error()), "status code: 404") && time.Since(startTime) < window {
+			log.Printf("[DEBUG] Resource is not found yet, waiting for it to become consistent: %v", e)
+			return nil, "", nil
+		}
+		return res, s, e
+	}
+}
+
 // Helper function to wait for update to reach terminal state before doing another update
 // Useful in situations where more than one update is needed and prior update needs to complete
 func waitForUpdatedState(d *schema.ResourceData, sync ResourceUpdater) error {
@@ -548,6 +713,15 @@ func waitForStateRefresh(sync StatefulResource, timeout time.Duration, operation
 		Timeout: timeout,
 	}
 
+	// Only a resource that was just created may be missing because of eventual consistency, a missing resource is the target of a deletion
+	if eventuallyConsistent, ok := sync.(EventuallyConsistentResource); ok && eventuallyConsistent.IsEventuallyConsistent() && operationName == "creation" {
+		stateConf.Refresh = eventuallyConsistentStateRefreshFunc(sync, eventualConsistencyWindow)
+		// The window, not the number of checks, limits how long a missing resource is polled
+		stateConf.NotFoundChecks = math.MaxInt32
+	}
+
+	setPollInterval(stateConf, pollIntervalFor(sync))
+
 	// Should not wait when in replay mode
 	if httpreplay.ShouldRetryImmediately() {
 		stateConf.PollInterval = 1
@@ -699,6 +873,9 @@ func WaitForResourceCondition(s ResourceFetcher, resourceChangedFunc func() bool
 		}
 
 		backoffTime = backoffTime * 2
+		if interval := pollIntervalFor(s); interval > 0 && backoffTime > interval {
+			backoffTime = interval
+		}
 
 		// If next attempt occurs after timeout, then retry earlier
 		nextAttemptTime := time.Now().Add(backoffTime)
@@ -865,6 +1042,7 @@ func WaitForWorkRequest(workRequestClient *oci_work_requests.WorkRequestClient,
 		},
 		Timeout: timeout,
 	}
+	setPollInterval(stateConf, providerPollInterval)
 	if _, e := stateConf.WaitForState(); e != nil {
 		return nil, e
 	}
@@ -880,7 +1058,8 @@ func WaitForWorkRequest(workRequestClient *oci_work_requests.WorkRequestClient,
 		}
 	}
 
-	if expectIdentifier && identifier == nil {
+	// The work request may have failed, check for errors if identifier is not found or work failed or got cancelled
+	if (expectIdentifier && identifier == nil) || response.Status == oci_work_requests.WorkRequestStatusFailed || response.Status == oci_work_requests.WorkRequestStatusCanceled {
 		return nil, getWorkRequestErrors(workRequestClient, workRequestId, retryPolicy, entityType, action)
 	}
 
@@ -925,11 +1104,36 @@ func getWorkRequestErrors(workRequestClient *oci_work_requests.WorkRequestClient
 	for _, wrkErr := range response.Items {
 		allErrs = append(allErrs, *wrkErr.Message)
 	}
-	errorMessage := strings.Join(allErrs, "\n")
 
-	workRequestErr := fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *workRequestId, entityType, action, errorMessage)
+	logs := make([]string, 0)
+	logsResponse, err := workRequestClient.ListWorkRequestLogs(context.Background(), oci_work_requests.ListWorkRequestLogsRequest{
+		WorkRequestId: workRequestId,
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: retryPolicy,
+		},
+	})
+	if err != nil {
+		log.Printf("[WARN] Could not list the log entries of work request %s: %v", *workRequestId, err)
+	}
+	for _, logEntry := range logsResponse.Items {
+		logs = append(logs, *logEntry.Message)
+	}
+
+	return newWorkRequestError(workRequestId, entityType, string(action), response.OpcRequestId, allErrs, logs)
+}
 
-	return workRequestErr
+// newWorkRequestError returns the error for a work request that did not succeed. Besides the errors reported by the
+// work request, it includes the opc-request-id of the request that listed them and the log entries of the work request,
+// which are what the service needs to troubleshoot the failure.
+func newWorkRequestError(workRequestId *string, entityType string, action string, opcRequestId *string, errorMessages []string, logMessages []string) error {
+	message := fmt.Sprintf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *workRequestId, entityType, action, strings.Join(errorMessages, "\n"))
+	if opcRequestId != nil && *opcRequestId != "" {
+		message += fmt.Sprintf("\nopc-request-id: %s", *opcRequestId)
+	}
+	if len(logMessages) > 0 {
+		message += fmt.Sprintf("\nWork request log entries:\n%s", strings.Join(logMessages, "\n"))
+	}
+	return fmt.Errorf("%s", message)
 }
 
 // Helper to marshal JSON objects from service into strings that can be stored in state.
@@ -4,10 +4,20 @@ package oci
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_load_balancer "github.com/oracle/oci-go-sdk/loadbalancer"
+	oci_work_requests "github.com/oracle/oci-go-sdk/workrequests"
+	"github.com/stretchr/testify/assert"
+
 	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
 )
 
@@ -89,3 +99,380 @@ func TestUnitWaitForResourceCondition_basic(t *testing.T) {
 		return
 	}
 }
+
+type testEventuallyConsistentResourceCrud struct {
+	BaseCrud
+	Res                  *testEventuallyConsistentResource
+	NotFoundCount        int
+	GetAttempts          int
+	eventuallyConsistent bool
+}
+
+type testEventuallyConsistentResource struct {
+	LifecycleState string
+}
+
+func (s *testEventuallyConsistentResourceCrud) ID() string {
+	return "ocid1.test.oc1..aaaa"
+}
+
+func (s *testEventuallyConsistentResourceCrud) Create() error {
+	s.Res = &testEventuallyConsistentResource{LifecycleState: "CREATING"}
+	return nil
+}
+
+func (s *testEventuallyConsistentResourceCrud) Get() error {
+	s.GetAttempts++
+	if s.GetAttempts <= s.NotFoundCount {
+		return fmt.Errorf("Service error:NotAuthorizedOrNotFound. Resource does not exist. http status code: 404")
+	}
+	s.Res = &testEventuallyConsistentResource{LifecycleState: "ENABLED"}
+	return nil
+}
+
+func (s *testEventuallyConsistentResourceCrud) SetData() error {
+	return nil
+}
+
+func (s *testEventuallyConsistentResourceCrud) CreatedPending() []string {
+	return []string{"CREATING"}
+}
+
+func (s *testEventuallyConsistentResourceCrud) CreatedTarget() []string {
+	return []string{"ENABLED"}
+}
+
+func (s *testEventuallyConsistentResourceCrud) IsEventuallyConsistent() bool {
+	return s.eventuallyConsistent
+}
+
+func TestUnitCreateResource_eventuallyConsistent(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+
+	// A resource that is not found right after it is created is polled until it reaches the created state
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	sync := &testEventuallyConsistentResourceCrud{NotFoundCount: 2, eventuallyConsistent: true}
+	sync.D = d
+	assert.Nil(t, CreateResource(d, sync))
+	assert.Equal(t, 3, sync.GetAttempts)
+	assert.Equal(t, "ocid1.test.oc1..aaaa", d.Id())
+	assert.Equal(t, "ENABLED", d.Get("state"))
+
+	// Other resources are removed from the state when they are not found while waiting
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	sync = &testEventuallyConsistentResourceCrud{NotFoundCount: 2}
+	sync.D = d
+	CreateResource(d, sync)
+	assert.Equal(t, 1, sync.GetAttempts)
+
+	// The resource is not polled once the eventual consistency window has passed
+	defer func() { eventualConsistencyWindow = defaultEventualConsistencyWindow }()
+	eventualConsistencyWindow = 0
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	sync = &testEventuallyConsistentResourceCrud{NotFoundCount: 2, eventuallyConsistent: true}
+	sync.D = d
+	CreateResource(d, sync)
+	assert.Equal(t, 1, sync.GetAttempts)
+}
+
+func TestUnitWaitForWorkRequest_failed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("opc-request-id", "request-id")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/errors"):
+			fmt.Fprint(w, `[{"code":"InternalError","message":"Attaching the DRG failed"}]`)
+		case strings.HasSuffix(r.URL.Path, "/logs"):
+			fmt.Fprint(w, `[{"message":"Moving DRG"},{"message":"Rolling back"}]`)
+		default:
+			fmt.Fprint(w, `{"id":"work-request-id","status":"FAILED","resources":[],"timeFinished":"2020-01-01T00:00:00.000Z"}`)
+		}
+	}))
+	defer server.Close()
+
+	password := "password"
+	client, err := oci_work_requests.NewWorkRequestClientWithConfigurationProvider(oci_common.NewRawConfigurationProvider(testTenancyOCID, testUserOCID, "us-phoenix-1", testKeyFingerPrint, testPrivateKey, &password))
+	assert.Nil(t, err)
+	client.Host = server.URL
+
+	// A failed work request is reported even when it is not expected to return the identifier of the resource
+	workRequestId := "work-request-id"
+	_, err = WaitForWorkRequest(&client, &workRequestId, "drg", oci_work_requests.WorkRequestResourceActionTypeUpdated, time.Minute, true, false)
+	assert.Error(t, err)
+	assert.Equal(t, "work request did not succeed, workId: work-request-id, entity: drg, action: UPDATED. Message: Attaching the DRG failed\n"+
+		"opc-request-id: request-id\n"+
+		"Work request log entries:\nMoving DRG\nRolling back", err.Error())
+}
+
+func TestUnitLoadBalancerWorkRequestError(t *testing.T) {
+	workRequestId := "work-request-id"
+	workRequestType := "CreateListener"
+	errorMessage := "The listener port is in use"
+	logMessage := "Creating the listener"
+	opcRequestId := "request-id"
+	wr := &oci_load_balancer.WorkRequest{
+		Id:           &workRequestId,
+		Type:         &workRequestType,
+		Message:      &logMessage,
+		ErrorDetails: []oci_load_balancer.WorkRequestError{{ErrorCode: oci_load_balancer.WorkRequestErrorErrorCodeBadInput, Message: &errorMessage}},
+	}
+
+	// The error keeps the prefix of the load balancer work request errors
+	err := loadBalancerWorkRequestError(wr, &opcRequestId)
+	assert.Equal(t, "WorkRequest FAILED: work request did not succeed, workId: work-request-id, entity: loadbalancer, action: CreateListener. Message: BAD_INPUT: The listener port is in use\n"+
+		"opc-request-id: request-id\n"+
+		"Work request log entries:\nCreating the listener", err.Error())
+}
+
+func TestUnitReadResource_notFound(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+
+	// A resource that was deleted outside of Terraform is removed from the state instead of failing the refresh
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	d.SetId("ocid1.test.oc1..aaaa")
+	sync := &testEventuallyConsistentResourceCrud{NotFoundCount: 1}
+	sync.D = d
+	assert.Nil(t, ReadResource(sync))
+	assert.Equal(t, "", d.Id())
+}
+
+type testCompartmentMoverCrud struct {
+	BaseCrud
+	MovedTo     []interface{}
+	UpdateCalls int
+}
+
+func (s *testCompartmentMoverCrud) Update() error {
+	s.UpdateCalls++
+	return nil
+}
+
+func (s *testCompartmentMoverCrud) SetData() error {
+	return nil
+}
+
+func (s *testCompartmentMoverCrud) ChangeCompartment(compartment interface{}) error {
+	s.MovedTo = append(s.MovedTo, compartment)
+	return nil
+}
+
+func TestUnitUpdateResource_compartmentMover(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"compartment_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"display_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+	state := &terraform.InstanceState{
+		ID: "ocid1.test.oc1..aaaa",
+		Attributes: map[string]string{
+			"compartment_id": "ocid1.compartment.oc1..old",
+			"display_name":   "name",
+		},
+	}
+	newResourceData := func(raw map[string]interface{}) *schema.ResourceData {
+		rawConfig, err := config.NewRawConfig(raw)
+		assert.Nil(t, err)
+		diff, err := schema.InternalMap(resourceSchema).Diff(state, terraform.NewResourceConfig(rawConfig), nil, nil, true)
+		assert.Nil(t, err)
+		d, err := schema.InternalMap(resourceSchema).Data(state, diff)
+		assert.Nil(t, err)
+		return d
+	}
+
+	// The resource is moved to the new compartment before it is updated
+	sync := &testCompartmentMoverCrud{}
+	sync.D = newResourceData(map[string]interface{}{"compartment_id": "ocid1.compartment.oc1..new", "display_name": "name"})
+	assert.Nil(t, UpdateResource(sync.D, sync))
+	assert.Equal(t, []interface{}{"ocid1.compartment.oc1..new"}, sync.MovedTo)
+	assert.Equal(t, 1, sync.UpdateCalls)
+
+	// The resource is not moved when the compartment is not changed
+	sync = &testCompartmentMoverCrud{}
+	sync.D = newResourceData(map[string]interface{}{"compartment_id": "ocid1.compartment.oc1..old", "display_name": "new name"})
+	assert.Nil(t, UpdateResource(sync.D, sync))
+	assert.Empty(t, sync.MovedTo)
+	assert.Equal(t, 1, sync.UpdateCalls)
+}
+
+type testDeletionProtectedCrud struct {
+	BaseCrud
+	Protected   bool
+	DeleteCalls int
+}
+
+func (s *testDeletionProtectedCrud) ID() string {
+	return "ocid1.test.oc1..aaaa"
+}
+
+func (s *testDeletionProtectedCrud) Delete() error {
+	s.DeleteCalls++
+	return nil
+}
+
+func (s *testDeletionProtectedCrud) IsDeletionProtected() bool {
+	return s.Protected
+}
+
+func TestUnitDeleteResource_deletionProtection(t *testing.T) {
+	defer func() { deletionProtection = false }()
+	newCrud := func(protected bool) *testDeletionProtectedCrud {
+		sync := &testDeletionProtectedCrud{Protected: protected}
+		sync.D = schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+		sync.D.SetId("ocid1.test.oc1..aaaa")
+		return sync
+	}
+
+	// Protected resources are not deleted while deletion protection is enabled
+	deletionProtection = true
+	sync := newCrud(true)
+	err := DeleteResource(sync.D, sync)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "deletion_protection")
+	assert.Equal(t, 0, sync.DeleteCalls)
+	assert.Equal(t, "ocid1.test.oc1..aaaa", sync.D.Id())
+
+	// Resources that are not protected at the moment, such as empty buckets, are deleted
+	sync = newCrud(false)
+	assert.Nil(t, DeleteResource(sync.D, sync))
+	assert.Equal(t, 1, sync.DeleteCalls)
+	assert.Equal(t, "", sync.D.Id())
+
+	// Protected resources are deleted when deletion protection is disabled
+	deletionProtection = false
+	sync = newCrud(true)
+	assert.Nil(t, DeleteResource(sync.D, sync))
+	assert.Equal(t, 1, sync.DeleteCalls)
+}
+
+type testPowerStateCrud struct {
+	BaseCrud
+	Res            *testEventuallyConsistentResource
+	LifecycleState string
+	Calls          []string
+}
+
+func (s *testPowerStateCrud) ID() string {
+	return "ocid1.test.oc1..aaaa"
+}
+
+func (s *testPowerStateCrud) Create() error {
+	s.Calls = append(s.Calls, "create")
+	s.LifecycleState = "RUNNING"
+	return nil
+}
+
+func (s *testPowerStateCrud) Get() error {
+	switch s.LifecycleState {
+	case "STARTING":
+		s.LifecycleState = "RUNNING"
+	case "STOPPING":
+		s.LifecycleState = "STOPPED"
+	}
+	s.Res = &testEventuallyConsistentResource{LifecycleState: s.LifecycleState}
+	return nil
+}
+
+func (s *testPowerStateCrud) Update() error {
+	s.Calls = append(s.Calls, "update")
+	return nil
+}
+
+func (s *testPowerStateCrud) SetData() error {
+	return nil
+}
+
+func (s *testPowerStateCrud) RunningState() string {
+	return "RUNNING"
+}
+
+func (s *testPowerStateCrud) StoppedState() string {
+	return "STOPPED"
+}
+
+func (s *testPowerStateCrud) Start() error {
+	s.Calls = append(s.Calls, "start")
+	s.LifecycleState = "STARTING"
+	return nil
+}
+
+func (s *testPowerStateCrud) Stop() error {
+	s.Calls = append(s.Calls, "stop")
+	s.LifecycleState = "STOPPING"
+	return nil
+}
+
+func TestUnitPowerStateResource(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"state": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+	}
+	newResourceData := func(currentState string, raw map[string]interface{}) *schema.ResourceData {
+		state := &terraform.InstanceState{
+			ID:         "ocid1.test.oc1..aaaa",
+			Attributes: map[string]string{"state": currentState},
+		}
+		rawConfig, err := config.NewRawConfig(raw)
+		assert.Nil(t, err)
+		diff, err := schema.InternalMap(resourceSchema).Diff(state, terraform.NewResourceConfig(rawConfig), nil, nil, true)
+		assert.Nil(t, err)
+		d, err := schema.InternalMap(resourceSchema).Data(state, diff)
+		assert.Nil(t, err)
+		return d
+	}
+
+	// Resources are stopped once they are created when the stopped state is configured
+	sync := &testPowerStateCrud{}
+	sync.D = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"state": "stopped"})
+	assert.Nil(t, CreateResource(sync.D, sync))
+	assert.Equal(t, []string{"create", "stop"}, sync.Calls)
+	assert.Equal(t, "STOPPED", sync.D.Get("state"))
+
+	// and are left running otherwise
+	sync = &testPowerStateCrud{}
+	sync.D = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	assert.Nil(t, CreateResource(sync.D, sync))
+	assert.Equal(t, []string{"create"}, sync.Calls)
+
+	// Resources are started before they are updated
+	sync = &testPowerStateCrud{LifecycleState: "STOPPED"}
+	sync.D = newResourceData("STOPPED", map[string]interface{}{"state": "RUNNING"})
+	assert.Nil(t, UpdateResource(sync.D, sync))
+	assert.Equal(t, []string{"start", "update"}, sync.Calls)
+	assert.Equal(t, "RUNNING", sync.D.Get("state"))
+
+	// and stopped after they are updated
+	sync = &testPowerStateCrud{LifecycleState: "RUNNING"}
+	sync.D = newResourceData("RUNNING", map[string]interface{}{"state": "STOPPED"})
+	assert.Nil(t, UpdateResource(sync.D, sync))
+	assert.Equal(t, []string{"update", "stop"}, sync.Calls)
+	assert.Equal(t, "STOPPED", sync.D.Get("state"))
+
+	// Resources that are already in the configured state are not started or stopped
+	sync = &testPowerStateCrud{LifecycleState: "STOPPED"}
+	sync.D = newResourceData("RUNNING", map[string]interface{}{"state": "STOPPED"})
+	assert.Nil(t, UpdateResource(sync.D, sync))
+	assert.Equal(t, []string{"update"}, sync.Calls)
+
+	// The state is left as it is when it is not changed
+	sync = &testPowerStateCrud{LifecycleState: "STOPPED"}
+	sync.D = newResourceData("STOPPED", map[string]interface{}{"state": "STOPPED"})
+	assert.Nil(t, UpdateResource(sync.D, sync))
+	assert.Equal(t, []string{"update"}, sync.Calls)
+}
@@ -63,6 +63,47 @@ type ResourceDeleter interface {
 	Delete() error
 }
 
+// Resources that can be moved to another compartment without being recreated implement this interface.
+// UpdateResource moves them when compartment_id is changed, before the rest of the update is applied.
+// ChangeCompartment should wait for the move to complete, since some services reject other updates
+// while a resource is being moved.
+type CompartmentMover interface {
+	ResourceUpdater
+	ChangeCompartment(compartment interface{}) error
+}
+
+// Resources that hold data or keys that cannot be recovered once they are
+// deleted implement this interface. DeleteResource fails instead of
+// deleting them while deletion_protection is enabled in the provider and
+// IsDeletionProtected returns true.
+type DeletionProtectedResource interface {
+	ResourceDeleter
+	IsDeletionProtected() bool
+}
+
+// Resources that can be stopped and started declaratively take the state they
+// should be in from their state argument and implement this interface.
+// CreateResource stops them once they are created when state is set to
+// StoppedState, and UpdateResource starts them before, or stops them after, the
+// rest of the update when state is changed. Start and Stop wait for the work
+// request of the change when the service returns one, the CRUD helpers then wait
+// for the lifecycle state to reach the target.
+type PowerStateResource interface {
+	StatefulResource
+	RunningState() string
+	StoppedState() string
+	Start() error
+	Stop() error
+}
+
+// Resources whose operations take hours, such as the provisioning of DB
+// systems, implement this interface to be polled less often while waiting for
+// their lifecycle state. The longer of PollInterval and the poll_interval of
+// the provider is used, PollInterval is ignored when poll_interval is not set.
+type PollIntervalResource interface {
+	PollInterval() time.Duration
+}
+
 // Some resources in the oracle API are removed asynchronously, so even
 // after they claim to be gone, other dependencies haven't been notified
 // of that fact. This facility allows us to add an artificial delay for
@@ -81,6 +122,17 @@ type ExtraWaitPostDelete interface {
 	ExtraWaitPostDelete() time.Duration
 }
 
+// Some resources in the oracle API, such as identity compartments and
+// policies and KMS keys and key versions, can not be read for a short time
+// after they are created. Implementing this interface makes the wait for the
+// created state treat the resource as pending while it is not found, for up
+// to the eventual consistency window, instead of sleeping for a fixed time
+// before reading it.
+type EventuallyConsistentResource interface {
+	StatefullyCreatedResource
+	IsEventuallyConsistent() bool
+}
+
 type StatefulResource interface {
 	ResourceReader
 	State() string
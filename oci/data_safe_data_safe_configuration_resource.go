@@ -4,7 +4,6 @@ package oci
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -214,6 +213,7 @@ func dataSafeConfigurationWaitForWorkRequest(wId *string, entityType string, act
 		},
 		Timeout: timeout,
 	}
+	setPollInterval(stateConf, providerPollInterval)
 	if _, e := stateConf.WaitForState(); e != nil {
 		return nil, e
 	}
@@ -250,9 +250,23 @@ func getErrorFromDataSafeConfigurationWorkRequest(client *oci_data_safe.DataSafe
 	for _, wrkErr := range response.Items {
 		allErrs = append(allErrs, *wrkErr.Message)
 	}
-	errorMessage := strings.Join(allErrs, "\n")
-	workRequestErr := fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *wId, entityType, action, errorMessage)
-	return workRequestErr
+
+	logs := make([]string, 0)
+	logsResponse, err := client.ListWorkRequestLogs(context.Background(),
+		oci_data_safe.ListWorkRequestLogsRequest{
+			WorkRequestId: wId,
+			RequestMetadata: oci_common.RequestMetadata{
+				RetryPolicy: retryPolicy,
+			},
+		})
+	if err != nil {
+		log.Printf("[WARN] Could not list the log entries of work request %s: %v", *wId, err)
+	}
+	for _, logEntry := range logsResponse.Items {
+		logs = append(logs, *logEntry.Message)
+	}
+
+	return newWorkRequestError(wId, entityType, string(action), response.OpcRequestId, allErrs, logs)
 }
 
 func (s *DataSafeDataSafeConfigurationResourceCrud) Get() error {
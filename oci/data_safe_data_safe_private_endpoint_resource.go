@@ -4,7 +4,6 @@ package oci
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -313,6 +312,7 @@ func dataSafePrivateEndpointWaitForWorkRequest(wId *string, entityType string, a
 		},
 		Timeout: timeout,
 	}
+	setPollInterval(stateConf, providerPollInterval)
 	if _, e := stateConf.WaitForState(); e != nil {
 		return nil, e
 	}
@@ -350,9 +350,23 @@ func getErrorFromDataSafePrivateEndpointWorkRequest(client *oci_data_safe.DataSa
 	for _, wrkErr := range response.Items {
 		allErrs = append(allErrs, *wrkErr.Message)
 	}
-	errorMessage := strings.Join(allErrs, "\n")
-	workRequestErr := fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *wId, entityType, action, errorMessage)
-	return workRequestErr
+
+	logs := make([]string, 0)
+	logsResponse, err := client.ListWorkRequestLogs(context.Background(),
+		oci_data_safe.ListWorkRequestLogsRequest{
+			WorkRequestId: wId,
+			RequestMetadata: oci_common.RequestMetadata{
+				RetryPolicy: retryPolicy,
+			},
+		})
+	if err != nil {
+		log.Printf("[WARN] Could not list the log entries of work request %s: %v", *wId, err)
+	}
+	for _, logEntry := range logsResponse.Items {
+		logs = append(logs, *logEntry.Message)
+	}
+
+	return newWorkRequestError(wId, entityType, string(action), response.OpcRequestId, allErrs, logs)
 }
 
 func (s *DataSafeDataSafePrivateEndpointResourceCrud) Get() error {
@@ -288,8 +288,14 @@ func DatabaseAutonomousDatabaseResource() *schema.Resource {
 				Computed: true,
 			},
 			"state": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.AutonomousDatabaseLifecycleStateAvailable),
+					string(oci_database.AutonomousDatabaseLifecycleStateStopped),
+				}, true),
 			},
 			"system_tags": {
 				Type:     schema.TypeMap,
@@ -427,6 +433,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) UpdatedPending() []string {
 func (s *DatabaseAutonomousDatabaseResourceCrud) UpdatedTarget() []string {
 	return []string{
 		string(oci_database.AutonomousDatabaseLifecycleStateAvailable),
+		string(oci_database.AutonomousDatabaseLifecycleStateStopped),
 	}
 }
 
@@ -605,6 +612,42 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Delete() error {
 	return err
 }
 
+func (s *DatabaseAutonomousDatabaseResourceCrud) IsDeletionProtected() bool {
+	return true
+}
+
+func (s *DatabaseAutonomousDatabaseResourceCrud) RunningState() string {
+	return string(oci_database.AutonomousDatabaseLifecycleStateAvailable)
+}
+
+func (s *DatabaseAutonomousDatabaseResourceCrud) StoppedState() string {
+	return string(oci_database.AutonomousDatabaseLifecycleStateStopped)
+}
+
+func (s *DatabaseAutonomousDatabaseResourceCrud) Start() error {
+	request := oci_database.StartAutonomousDatabaseRequest{}
+
+	tmp := s.D.Id()
+	request.AutonomousDatabaseId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	_, err := s.Client.StartAutonomousDatabase(context.Background(), request)
+	return err
+}
+
+func (s *DatabaseAutonomousDatabaseResourceCrud) Stop() error {
+	request := oci_database.StopAutonomousDatabaseRequest{}
+
+	tmp := s.D.Id()
+	request.AutonomousDatabaseId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	_, err := s.Client.StopAutonomousDatabase(context.Background(), request)
+	return err
+}
+
 func (s *DatabaseAutonomousDatabaseResourceCrud) SetData() error {
 	if s.Res.AutonomousContainerDatabaseId != nil {
 		s.D.Set("autonomous_container_database_id", *s.Res.AutonomousContainerDatabaseId)
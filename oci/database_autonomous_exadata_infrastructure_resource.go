@@ -5,6 +5,7 @@ package oci
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -358,6 +359,10 @@ func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) UpdatedTarget() []
 	}
 }
 
+func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) PollInterval() time.Duration {
+	return longRunningPollInterval
+}
+
 func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Create() error {
 	request := oci_database.LaunchAutonomousExadataInfrastructureRequest{}
 
@@ -810,6 +810,10 @@ func (s *DatabaseDbSystemResourceCrud) UpdatedTarget() []string {
 	}
 }
 
+func (s *DatabaseDbSystemResourceCrud) PollInterval() time.Duration {
+	return longRunningPollInterval
+}
+
 func (s *DatabaseDbSystemResourceCrud) Create() error {
 	request := oci_database.LaunchDbSystemRequest{}
 	err := s.populateTopLevelPolymorphicLaunchDbSystemRequest(&request)
@@ -992,6 +996,10 @@ func (s *DatabaseDbSystemResourceCrud) Delete() error {
 	return err
 }
 
+func (s *DatabaseDbSystemResourceCrud) IsDeletionProtected() bool {
+	return true
+}
+
 func (s *DatabaseDbSystemResourceCrud) SetData() error {
 
 	if s.Res.IormConfigCache != nil {
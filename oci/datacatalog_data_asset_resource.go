@@ -406,8 +406,9 @@ func mapToProperties(rawMap map[string]interface{}) (map[string]map[string]strin
 	properties := map[string]map[string]string{}
 	if len(rawMap) > 0 {
 		for key, value := range rawMap {
-			var keyComponents = strings.Split(key, ".")
-			if len(keyComponents) != 2 {
+			// Only the first "." separates the namespace; property names may themselves contain dots
+			var keyComponents = strings.SplitN(key, ".", 2)
+			if len(keyComponents) != 2 || keyComponents[0] == "" || keyComponents[1] == "" {
 				return nil, fmt.Errorf("invalid key structure found %s", key)
 			}
 			var namespace = keyComponents[0]
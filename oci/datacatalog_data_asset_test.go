@@ -5,6 +5,7 @@ package oci
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strconv"
 	"testing"
 
@@ -236,3 +237,37 @@ func testAccCheckDatacatalogDataAssetDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestUnitDatacatalogDataAsset_mapToProperties(t *testing.T) {
+	rawMap := map[string]interface{}{
+		"default.host":          "host1",
+		"default.jdbc.url":      "jdbc:oracle:thin:@host1:1521/orcl",
+		"customNamespace.alias": "a",
+	}
+	expected := map[string]map[string]string{
+		"default": {
+			"host":     "host1",
+			"jdbc.url": "jdbc:oracle:thin:@host1:1521/orcl",
+		},
+		"customNamespace": {
+			"alias": "a",
+		},
+	}
+
+	properties, err := mapToProperties(rawMap)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(properties, expected) {
+		t.Errorf("expected %v, got %v", expected, properties)
+	}
+	if roundTrip := propertiesToMap(properties); !reflect.DeepEqual(roundTrip, rawMap) {
+		t.Errorf("expected round trip to return %v, got %v", rawMap, roundTrip)
+	}
+
+	for _, key := range []string{"host", ".host", "default."} {
+		if _, err := mapToProperties(map[string]interface{}{key: "value"}); err == nil {
+			t.Errorf("expected an error for key %q", key)
+		}
+	}
+}
@@ -10,6 +10,10 @@ import (
 	oci_email "github.com/oracle/oci-go-sdk/email"
 )
 
+// A sender moves to NEEDS_ATTENTION instead of ACTIVE when its SPF or DKIM configuration
+// still has to be verified. This state is not yet modeled by the SDK.
+const emailSenderLifecycleStateNeedsAttention = "NEEDS_ATTENTION"
+
 func init() {
 	RegisterResource("oci_email_sender", EmailSenderResource())
 }
@@ -121,6 +125,7 @@ func (s *EmailSenderResourceCrud) CreatedPending() []string {
 func (s *EmailSenderResourceCrud) CreatedTarget() []string {
 	return []string{
 		string(oci_email.SenderLifecycleStateActive),
+		emailSenderLifecycleStateNeedsAttention,
 	}
 }
 
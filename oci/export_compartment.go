@@ -2,9 +2,12 @@ package oci
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
 	"regexp"
 	"runtime"
 	"sort"
@@ -13,11 +16,15 @@ import (
 	"github.com/fatih/color"
 
 	"github.com/hashicorp/terraform/backend/local"
-	"github.com/mitchellh/cli"
 
 	"github.com/hashicorp/hcl/hcl/fmtcmd"
-	"github.com/hashicorp/terraform/command"
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/config/hcl2shim"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/plans/objchange"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/states/statefile"
+	"github.com/hashicorp/terraform/states/statemgr"
 	oci_common "github.com/oracle/oci-go-sdk/common"
 	oci_identity "github.com/oracle/oci-go-sdk/identity"
 )
@@ -26,6 +33,9 @@ const (
 	exportUserAgentFormatter        = "Oracle-GoSDK/%s (go/%s; %s/%s; terraform-oci-exporter/%s)"
 	defaultTmpStateFile             = "terraform.tfstate.tmp"
 	varsFile                        = "vars.tf"
+	providersFile                   = "provider.tf"
+	allRegions                      = "all"
+	importScriptFile                = "import.sh"
 	missingRequiredAttributeWarning = `Warning: There are one or more 'Required' attributes for which a value could not be discovered.
 This may be expected behavior from the service, which may prevent discovery of certain sensitive attributes or secrets.
 Run 'terraform plan' against the generated configuration files to get more information about the missing values.`
@@ -40,6 +50,9 @@ var compartmentScopeServices []string
 var isMissingRequiredAttributes bool
 var exportConfigProvider oci_common.ConfigurationProvider
 
+// Matches the resource or data source that an interpolation such as ${oci_core_vcn.vcn1.id} refers to
+var hclReferenceRegex = regexp.MustCompile(`\$\{(?:data\.)?([\w-]+\.[\w-]+)\.`)
+
 func init() {
 	resourceNameCount = map[string]int{}
 	vars = map[string]string{}
@@ -110,6 +123,8 @@ type ExportCommandArgs struct {
 	CompartmentName *string
 	IDs             []string
 	Services        []string
+	ExcludeTypes    []string
+	Regions         []string
 	OutputDir       *string
 	GenerateState   bool
 }
@@ -145,9 +160,41 @@ func RunExportCommand(args *ExportCommandArgs) error {
 		}
 	}
 
+	if len(args.Regions) == 1 && args.Regions[0] == allRegions {
+		var err error
+		args.Regions, err = getSubscribedRegions(clients.(*OracleClients))
+		if err != nil {
+			return err
+		}
+	}
+
 	return runExportCommand(clients.(*OracleClients), args)
 }
 
+// getSubscribedRegions returns the names of the regions that the tenancy is subscribed to and that are ready to use
+func getSubscribedRegions(clients *OracleClients) ([]string, error) {
+	tenancyId, err := exportConfigProvider.TenancyOCID()
+	if err != nil {
+		return nil, err
+	}
+
+	request := oci_identity.ListRegionSubscriptionsRequest{TenancyId: &tenancyId}
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(true, "identity")
+	response, err := clients.identityClient.ListRegionSubscriptions(context.Background(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	regions := []string{}
+	for _, subscription := range response.Items {
+		if subscription.RegionName != nil && subscription.Status == oci_identity.RegionSubscriptionStatusReady {
+			regions = append(regions, *subscription.RegionName)
+		}
+	}
+	sort.Strings(regions)
+	return regions, nil
+}
+
 // Dedupes possible repeating services from command line and sorts them
 func (args *ExportCommandArgs) finalizeServices() {
 	seenServices := map[string]bool{}
@@ -164,6 +211,22 @@ func (args *ExportCommandArgs) finalizeServices() {
 	sort.Strings(args.Services)
 }
 
+// Dedupes possible repeating regions from command line and sorts them
+func (args *ExportCommandArgs) finalizeRegions() {
+	seenRegions := map[string]bool{}
+	finalRegions := []string{}
+
+	for _, region := range args.Regions {
+		if seenRegions[region] {
+			continue
+		}
+		finalRegions = append(finalRegions, region)
+		seenRegions[region] = true
+	}
+	args.Regions = finalRegions
+	sort.Strings(args.Regions)
+}
+
 // Validate export command arguments and returns nil if there are no issues
 func (args *ExportCommandArgs) validate() error {
 	path, err := os.Stat(*args.OutputDir)
@@ -175,9 +238,44 @@ func (args *ExportCommandArgs) validate() error {
 		return fmt.Errorf("[ERROR] output_path %s should be a directory", *args.OutputDir)
 	}
 
+	unknownServices := []string{}
+	for _, service := range args.Services {
+		_, isCompartmentService := compartmentResourceGraphs[service]
+		_, isTenancyService := tenancyResourceGraphs[service]
+		if !isCompartmentService && !isTenancyService {
+			unknownServices = append(unknownServices, service)
+		}
+	}
+	if len(unknownServices) > 0 {
+		return fmt.Errorf("[ERROR] services %s cannot be exported, run the list_export_resources command to list the services that can be exported", strings.Join(unknownServices, ", "))
+	}
+
+	for _, region := range args.Regions {
+		if region == "" || (region == allRegions && len(args.Regions) > 1) {
+			return fmt.Errorf("[ERROR] invalid regions '%s', specify either a list of regions or '%s'", strings.Join(args.Regions, ","), allRegions)
+		}
+	}
+
+	for _, pattern := range args.ExcludeTypes {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf("[ERROR] invalid exclude_types pattern '%s': %v", pattern, err)
+		}
+	}
+
 	return nil
 }
 
+// isExcluded returns whether resources of a type are excluded from the export by one of the exclude_types patterns,
+// such as oci_core_* for all the core resources
+func (args *ExportCommandArgs) isExcluded(terraformClass string) bool {
+	for _, pattern := range args.ExcludeTypes {
+		if matched, _ := filepath.Match(pattern, terraformClass); matched {
+			return true
+		}
+	}
+	return false
+}
+
 func getExportConfig(d *schema.ResourceData) (interface{}, error) {
 
 	clients := &OracleClients{configuration: map[string]string{}}
@@ -190,14 +288,13 @@ func getExportConfig(d *schema.ResourceData) (interface{}, error) {
 		return nil, err
 	}
 	exportConfigProvider = sdkConfigProvider
-	// beware: global variable `configureClient` set here--used elsewhere outside this execution path
-	configureClient, err := buildConfigureClientFn(sdkConfigProvider, httpClient)
+	configureExportClient, err := buildConfigureClientFn(sdkConfigProvider, httpClient)
 	if err != nil {
 		return nil, err
 	}
 
 	configureClientWithUserAgent := func(client *oci_common.BaseClient) error {
-		if err := configureClient(client); err != nil {
+		if err := configureExportClient(client); err != nil {
 			return err
 		}
 		client.UserAgent = userAgentString
@@ -208,6 +305,10 @@ func getExportConfig(d *schema.ResourceData) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	clients.sdkConfigProvider = sdkConfigProvider
+	// beware: global variable `configureClient` set here--used elsewhere outside this execution path, and to create
+	// the clients of the other regions that are exported
+	configureClient = configureClientWithUserAgent
 
 	return clients, nil
 }
@@ -226,7 +327,8 @@ func runExportCommand(clients *OracleClients, args *ExportCommandArgs) error {
 	}
 
 	args.finalizeServices()
-	generateConfigSteps, err := buildGenerateConfigSteps(args.CompartmentId, args.Services)
+	args.finalizeRegions()
+	generateConfigSteps, err := buildGenerateConfigSteps(args.CompartmentId, args.Services, args.Regions)
 	if err != nil {
 		return err
 	}
@@ -240,8 +342,15 @@ func runExportCommand(clients *OracleClients, args *ExportCommandArgs) error {
 	}
 
 	for _, step := range generateConfigSteps {
+		stepClients := clients
+		if step.root.region != "" {
+			if stepClients, err = clients.ForRegion(step.root.region); err != nil {
+				return err
+			}
+		}
+
 		// Discover all resources in the compartment
-		ociResources, err := findResources(clients, step.root, step.resourceGraph, matchResourceIds)
+		ociResources, err := findResources(stepClients, step.root, step.resourceGraph, matchResourceIds)
 		if err != nil {
 			return err
 		}
@@ -250,6 +359,9 @@ func runExportCommand(clients *OracleClients, args *ExportCommandArgs) error {
 		step.discoveredResources = []*OCIResource{}
 		step.omittedResources = []*OCIResource{}
 		for _, resource := range ociResources {
+			if args.isExcluded(resource.terraformClass) {
+				resource.omitFromExport = true
+			}
 			if !resource.omitFromExport {
 				referenceMap[resource.id] = resource.getHclReferenceIdString()
 				step.discoveredResources = append(step.discoveredResources, resource)
@@ -297,12 +409,19 @@ func runExportCommand(clients *OracleClients, args *ExportCommandArgs) error {
 		builder := &strings.Builder{}
 		builder.WriteString("## This configuration was generated by terraform-provider-oci\n\n")
 
+		resourceHcl := map[*OCIResource]string{}
 		for _, resource := range step.discoveredResources {
 			log.Printf("[INFO] ===> Generating resource '%s'", resource.getTerraformReference())
-			if err := resource.getHCLString(builder, referenceMap); err != nil {
+			resourceBuilder := &strings.Builder{}
+			if err := resource.getHCLString(resourceBuilder, referenceMap); err != nil {
 				_ = file.Close()
 				return err
 			}
+			resourceHcl[resource] = resourceBuilder.String()
+		}
+
+		for _, resource := range sortResourcesByReferences(step.discoveredResources, resourceHcl) {
+			builder.WriteString(resourceHcl[resource])
 			allDiscoveredResources = append(allDiscoveredResources, resource)
 		}
 
@@ -337,69 +456,23 @@ func runExportCommand(clients *OracleClients, args *ExportCommandArgs) error {
 		return err
 	}
 
-	if args.GenerateState {
-		// Run init and import commands
-		meta := command.Meta{
-			Ui: &cli.BasicUi{
-				Reader:      os.Stdin,
-				Writer:      os.Stdout,
-				ErrorWriter: os.Stderr,
-			},
-			RunningInAutomation: true,
-		}
-
-		initCmd := command.InitCommand{Meta: meta}
-		var initArgs []string
-		if pluginDir := getEnvSettingWithBlankDefault("provider_bin_path"); pluginDir != "" {
-			log.Printf("[INFO] plugin dir: '%s'", pluginDir)
-			initArgs = append(initArgs, fmt.Sprintf("-plugin-dir=%v", pluginDir))
-		}
-		initArgs = append(initArgs, *args.OutputDir)
-		if errCode := initCmd.Run(initArgs); errCode != 0 {
-			return nil
-		}
-
-		if err := os.RemoveAll(tmpStateOutputFile); err != nil {
-			log.Printf("[WARN] unable to delete existing tmp state file %s", tmpStateOutputFile)
+	if len(args.Regions) > 0 {
+		if err := generateProvidersFile(args.Regions, args.OutputDir); err != nil {
 			return err
 		}
+		summaryStatements = append(summaryStatements, fmt.Sprintf("Generated the providers of the exported regions under '%s%s%s'", *args.OutputDir, string(os.PathSeparator), providersFile))
+	}
 
-		for _, resource := range allDiscoveredResources {
-			log.Printf("[INFO] ===> Importing resource '%s'", resource.getTerraformReference())
-
-			resourceDefinition, exists := resourcesMap[resource.terraformClass]
-			if !exists {
-				log.Printf("[INFO] skip importing '%s' since it is not a Terraform OCI resource", resource.getTerraformReference())
-				continue
-			}
-
-			if resourceDefinition.Importer == nil {
-				log.Printf("[WARN] unable to import '%s' because import is not supported for '%s'", resource.getTerraformReference(), resource.terraformClass)
-				continue
-			}
-
-			importCmd := command.ImportCommand{Meta: meta}
-			importId := resource.importId
-			if len(importId) == 0 {
-				importId = resource.id
-			}
-
-			importArgs := []string{
-				fmt.Sprintf("-config=%s", *args.OutputDir),
-				fmt.Sprintf("-state=%s", tmpStateOutputFile),
-				resource.getTerraformReference(),
-				importId,
-			}
-			if errCode := importCmd.Run(importArgs); errCode != 0 {
-				return fmt.Errorf("[ERROR] terraform import command failed for resource '%s' at id '%s'", resource.getTerraformReference(), importId)
-			}
-		}
+	if err := generateImportScript(allDiscoveredResources, args.OutputDir); err != nil {
+		return err
+	}
+	summaryStatements = append(summaryStatements, fmt.Sprintf("Generated the commands to import the discovered resources under '%s%s%s'", *args.OutputDir, string(os.PathSeparator), importScriptFile))
 
-		if _, err := os.Stat(tmpStateOutputFile); !os.IsNotExist(err) {
-			if err := os.Rename(tmpStateOutputFile, stateOutputFile); err != nil {
-				return err
-			}
+	if args.GenerateState {
+		if err := generateStateFile(clients, allDiscoveredResources, stateOutputFile, tmpStateOutputFile); err != nil {
+			return err
 		}
+		summaryStatements = append(summaryStatements, fmt.Sprintf("Generated the state of the discovered resources under '%s'", stateOutputFile))
 	}
 
 	if len(matchResourceIds) > 0 {
@@ -425,7 +498,10 @@ func runExportCommand(clients *OracleClients, args *ExportCommandArgs) error {
 	return nil
 }
 
-func buildGenerateConfigSteps(compartmentId *string, services []string) ([]*GenerateConfigStep, error) {
+// buildGenerateConfigSteps returns the steps to discover the resources of each service. When regions are given, the
+// resources of regional services are discovered in each of the regions, with a step for each of them, and are
+// managed by a provider with an alias for their region
+func buildGenerateConfigSteps(compartmentId *string, services []string, regions []string) ([]*GenerateConfigStep, error) {
 	result := []*GenerateConfigStep{}
 
 	// Note: In case of Instance Principal auth, the TenancyOCID will return
@@ -462,26 +538,39 @@ func buildGenerateConfigSteps(compartmentId *string, services []string) ([]*Gene
 	if compartmentId == nil || *compartmentId == "" {
 		*compartmentId = tenancyId
 	}
-	compartmentResource := &OCIResource{
-		compartmentId: *compartmentId,
-		TerraformResource: TerraformResource{
-			id:             *compartmentId,
-			terraformClass: "oci_identity_compartment",
-			terraformName:  "export",
-		},
-	}
 
 	for _, mode := range services {
-		if resourceGraph, exists := compartmentResourceGraphs[mode]; exists {
+		resourceGraph, exists := compartmentResourceGraphs[mode]
+		if !exists {
+			continue
+		}
+
+		stepRegions := regions
+		if len(stepRegions) == 0 || globalCompartmentServices[mode] {
+			stepRegions = []string{""}
+		}
+		for _, region := range stepRegions {
+			stepName := mode
+			if region != "" {
+				stepName = fmt.Sprintf("%s_%s", mode, region)
+			}
 			result = append(result, &GenerateConfigStep{
-				root:          compartmentResource,
+				root: &OCIResource{
+					compartmentId: *compartmentId,
+					region:        region,
+					TerraformResource: TerraformResource{
+						id:             *compartmentId,
+						terraformClass: "oci_identity_compartment",
+						terraformName:  "export",
+					},
+				},
 				resourceGraph: resourceGraph,
-				stepName:      mode,
+				stepName:      stepName,
 			})
-
-			vars["compartment_ocid"] = ""
-			referenceMap[*compartmentId] = "${var.compartment_ocid}"
 		}
+
+		vars["compartment_ocid"] = ""
+		referenceMap[*compartmentId] = "${var.compartment_ocid}"
 	}
 
 	return result, nil
@@ -537,6 +626,38 @@ func findResources(clients *OracleClients, root *OCIResource, resourceGraph Terr
 	return foundResources, nil
 }
 
+// sortResourcesByReferences orders resources so that the resources referred to in the HCL of a resource come before
+// it, such as a VCN before its subnets and the subnets before their instances. Resources are otherwise kept in the
+// order they were discovered in.
+func sortResourcesByReferences(resources []*OCIResource, resourceHcl map[*OCIResource]string) []*OCIResource {
+	resourcesByReference := map[string]*OCIResource{}
+	for _, resource := range resources {
+		resourcesByReference[resource.getTerraformReference()] = resource
+	}
+
+	result := []*OCIResource{}
+	visited := map[*OCIResource]bool{}
+
+	var visit func(resource *OCIResource)
+	visit = func(resource *OCIResource) {
+		if visited[resource] {
+			return
+		}
+		visited[resource] = true
+		for _, match := range hclReferenceRegex.FindAllStringSubmatch(resourceHcl[resource], -1) {
+			if referenced, ok := resourcesByReference[match[1]]; ok {
+				visit(referenced)
+			}
+		}
+		result = append(result, resource)
+	}
+
+	for _, resource := range resources {
+		visit(resource)
+	}
+	return result
+}
+
 func generateVarsFile(vars map[string]string, outputDir *string) error {
 	varsTmpFile := fmt.Sprintf("%s%s%s.tmp", *outputDir, string(os.PathSeparator), varsFile)
 	varsOutputFile := fmt.Sprintf("%s%s%s", *outputDir, string(os.PathSeparator), varsFile)
@@ -564,6 +685,175 @@ func generateVarsFile(vars map[string]string, outputDir *string) error {
 	return nil
 }
 
+// generateImportScript writes a shell script with a 'terraform import' command for each discovered resource that can
+// be imported, to import the resources into a state of the user's choice instead of generating one
+func generateImportScript(resources []*OCIResource, outputDir *string) error {
+	scriptTmpFile := fmt.Sprintf("%s%s%s.tmp", *outputDir, string(os.PathSeparator), importScriptFile)
+	scriptOutputFile := fmt.Sprintf("%s%s%s", *outputDir, string(os.PathSeparator), importScriptFile)
+
+	builder := &strings.Builder{}
+	builder.WriteString("#!/bin/sh\n")
+	builder.WriteString("## This script was generated by terraform-provider-oci\n")
+	builder.WriteString("## Run it in this directory after 'terraform init' to import the discovered resources\n")
+	builder.WriteString("set -e\n\n")
+
+	for _, resource := range resources {
+		if resourceDefinition, exists := resourcesMap[resource.terraformClass]; !exists || resourceDefinition.Importer == nil {
+			builder.WriteString(fmt.Sprintf("# '%s' cannot be imported\n", resource.getTerraformReference()))
+			continue
+		}
+		builder.WriteString(fmt.Sprintf("terraform import %s %s\n", shellQuote(resource.getTerraformReference()), shellQuote(resource.getImportId())))
+	}
+
+	if err := ioutil.WriteFile(scriptTmpFile, []byte(builder.String()), 0755); err != nil {
+		return err
+	}
+
+	return os.Rename(scriptTmpFile, scriptOutputFile)
+}
+
+// generateProvidersFile writes a provider with an alias for each exported region, for the resources discovered in
+// that region. Only the region is written, the aliased providers read their other settings, such as auth and
+// credentials, from the TF_VAR_ and OCI_ environment variables and the OCI config file like any other provider.
+// Settings made in the block of the provider without an alias are not copied to them.
+func generateProvidersFile(regions []string, outputDir *string) error {
+	providersTmpFile := fmt.Sprintf("%s%s%s.tmp", *outputDir, string(os.PathSeparator), providersFile)
+	providersOutputFile := fmt.Sprintf("%s%s%s", *outputDir, string(os.PathSeparator), providersFile)
+
+	builder := &strings.Builder{}
+	builder.WriteString("## This configuration was generated by terraform-provider-oci\n\n")
+	for _, region := range regions {
+		builder.WriteString(fmt.Sprintf("provider oci {\nalias = \"%s\"\nregion = \"%s\"\n}\n\n", getProviderAlias(region), region))
+	}
+
+	if err := ioutil.WriteFile(providersTmpFile, []byte(builder.String()), 0666); err != nil {
+		return err
+	}
+	if err := os.Rename(providersTmpFile, providersOutputFile); err != nil {
+		return err
+	}
+
+	return fmtcmd.Run([]string{providersOutputFile}, []string{}, nil, nil, fmtcmd.Options{Write: true})
+}
+
+// getProviderAlias returns the alias of the provider for the resources of a region, such as us_phoenix_1
+func getProviderAlias(region string) string {
+	return strings.Replace(region, "-", "_", -1)
+}
+
+// generateStateFile reads each discovered resource that can be imported the way 'terraform import' does, and writes
+// them all to a Terraform state file at once, instead of running an import command for each of them
+func generateStateFile(clients *OracleClients, resources []*OCIResource, stateOutputFile string, tmpStateOutputFile string) error {
+	state := states.NewState()
+	module := state.EnsureModule(addrs.RootModuleInstance)
+
+	for _, resource := range resources {
+		resourceDefinition, exists := resourcesMap[resource.terraformClass]
+		if !exists {
+			log.Printf("[INFO] skip importing '%s' since it is not a Terraform OCI resource", resource.getTerraformReference())
+			continue
+		}
+
+		if resourceDefinition.Importer == nil {
+			log.Printf("[WARN] unable to import '%s' because import is not supported for '%s'", resource.getTerraformReference(), resource.terraformClass)
+			continue
+		}
+
+		log.Printf("[INFO] ===> Importing resource '%s'", resource.getTerraformReference())
+		providerConfig := addrs.ProviderConfig{Type: "oci"}
+		resourceClients := clients
+		if resource.region != "" {
+			providerConfig.Alias = getProviderAlias(resource.region)
+			var err error
+			if resourceClients, err = clients.ForRegion(resource.region); err != nil {
+				return err
+			}
+		}
+
+		importId := resource.getImportId()
+		instanceObject, err := importResourceState(resourceClients, resourceDefinition, importId)
+		if err != nil {
+			return fmt.Errorf("[ERROR] unable to import resource '%s' at id '%s': %v", resource.getTerraformReference(), importId, err)
+		}
+		if instanceObject == nil {
+			log.Printf("[WARN] resource '%s' at id '%s' no longer exists and was not imported", resource.getTerraformReference(), importId)
+			continue
+		}
+
+		resourceAddr := addrs.Resource{
+			Mode: addrs.ManagedResourceMode,
+			Type: resource.terraformClass,
+			Name: resource.terraformName,
+		}
+		module.SetResourceInstanceCurrent(resourceAddr.Instance(addrs.NoKey), instanceObject, providerConfig.Absolute(addrs.RootModuleInstance))
+	}
+
+	file, err := os.OpenFile(tmpStateOutputFile, os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0666)
+	if err != nil {
+		return err
+	}
+	if err := statefile.Write(statefile.New(state, statemgr.NewLineage(), 1), file); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpStateOutputFile, stateOutputFile)
+}
+
+// importResourceState imports a resource and refreshes it, and returns it the way it is stored in the state, or nil if
+// it is not found
+func importResourceState(clients *OracleClients, resourceDefinition *schema.Resource, importId string) (*states.ResourceInstanceObjectSrc, error) {
+	d := resourceDefinition.Data(nil)
+	d.SetId(importId)
+
+	importedData := []*schema.ResourceData{d}
+	if resourceDefinition.Importer.State != nil {
+		var err error
+		if importedData, err = resourceDefinition.Importer.State(d, clients); err != nil {
+			return nil, err
+		}
+		if len(importedData) == 0 {
+			return nil, nil
+		}
+	}
+
+	instanceState, err := resourceDefinition.RefreshWithoutUpgrade(importedData[0].State(), clients)
+	if err != nil {
+		return nil, err
+	}
+	if instanceState == nil || instanceState.ID == "" {
+		return nil, nil
+	}
+	instanceState.Attributes["id"] = instanceState.ID
+
+	schemaBlock := resourceDefinition.CoreConfigSchema()
+	value, err := hcl2shim.HCL2ValueFromFlatmap(instanceState.Attributes, schemaBlock.ImpliedType())
+	if err != nil {
+		return nil, err
+	}
+	value = objchange.NormalizeObjectFromLegacySDK(value, schemaBlock)
+
+	private, err := json.Marshal(instanceState.Meta)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceObject := &states.ResourceInstanceObject{
+		Value:   value,
+		Private: private,
+		Status:  states.ObjectReady,
+	}
+	return instanceObject.Encode(schemaBlock.ImpliedType(), uint64(resourceDefinition.SchemaVersion))
+}
+
+// shellQuote quotes a value as a single argument of a shell command
+func shellQuote(value string) string {
+	return "'" + strings.Replace(value, "'", `'\''`, -1) + "'"
+}
+
 type OCIResource struct {
 	TerraformResource
 	compartmentId    string
@@ -571,6 +861,11 @@ type OCIResource struct {
 	sourceAttributes map[string]interface{}
 	getHclStringFn   func(*strings.Builder, *OCIResource, map[string]string) error
 	parent           *OCIResource
+	// The region the resource was discovered in, when more than the region of the provider are exported
+	region string
+	// Attributes of the parent that the resource was discovered with, other than its id, such as the management
+	// endpoint of the vault of a key
+	referencedParentAttributes []string
 }
 
 type TerraformResource struct {
@@ -594,6 +889,15 @@ func (tr *TerraformResource) getTerraformReference() string {
 	return fmt.Sprintf("%s.%s", tr.terraformClass, tr.terraformName)
 }
 
+// getImportId returns the identifier to import the resource with, which is its OCID unless it has a composite
+// import identifier
+func (tr *TerraformResource) getImportId() string {
+	if tr.importId != "" {
+		return tr.importId
+	}
+	return tr.id
+}
+
 func getHCLStringFromMap(builder *strings.Builder, sourceAttributes map[string]interface{}, resourceSchema *schema.Resource, interpolationMap map[string]string) error {
 	sortedKeys := make([]string, len(resourceSchema.Schema))
 	cnt := 0
@@ -704,7 +1008,7 @@ func getHCLStringFromMap(builder *strings.Builder, sourceAttributes map[string]i
 			log.Printf("[WARN] Required TF attribute '%s' not found in source\n", tfAttribute)
 			builder.WriteString(fmt.Sprintf("#%s = <<Required attribute not found in discovery>>\n", tfAttribute))
 			isMissingRequiredAttributes = true
-		} else if tfSchema.Optional {
+		} else if tfSchema.Optional && tfAttribute != regionOverrideAttrName {
 			log.Printf("[INFO] Optional TF attribute '%s' not found in source\n", tfAttribute)
 			builder.WriteString(fmt.Sprintf("#%s = <<Optional value not found in discovery>>\n", tfAttribute))
 		}
@@ -722,6 +1026,17 @@ func (ociRes *OCIResource) getHCLString(builder *strings.Builder, interpolationM
 		}
 	}
 
+	// Refer to the attributes of the parent the resource was discovered with, when the parent is exported with it
+	if parent := ociRes.parent; parent != nil && interpolationMap[parent.id] == parent.getHclReferenceIdString() {
+		for _, parentAttribute := range ociRes.referencedParentAttributes {
+			value, ok := parent.sourceAttributes[parentAttribute].(string)
+			if _, exists := resourceInterpolationMap[value]; !ok || value == "" || exists {
+				continue
+			}
+			resourceInterpolationMap[value] = fmt.Sprintf("${%s.%s}", parent.getTerraformReference(), parentAttribute)
+		}
+	}
+
 	if ociRes.getHclStringFn != nil {
 		return ociRes.getHclStringFn(builder, ociRes, resourceInterpolationMap)
 	}
@@ -732,6 +1047,7 @@ func getHclStringFromGenericMap(builder *strings.Builder, ociRes *OCIResource, i
 	resourceSchema := resourcesMap[ociRes.terraformClass]
 
 	builder.WriteString(fmt.Sprintf("resource %s %s {\n", ociRes.terraformClass, ociRes.terraformName))
+	ociRes.writeProviderAlias(builder)
 	if err := getHCLStringFromMap(builder, ociRes.sourceAttributes, resourceSchema, interpolationMap); err != nil {
 		return err
 	}
@@ -740,6 +1056,13 @@ func getHclStringFromGenericMap(builder *strings.Builder, ociRes *OCIResource, i
 	return nil
 }
 
+// writeProviderAlias sets the provider of a resource or data source to the provider of the region it was discovered in
+func (ociRes *OCIResource) writeProviderAlias(builder *strings.Builder) {
+	if ociRes.region != "" {
+		builder.WriteString(fmt.Sprintf("provider = oci.%s\n", getProviderAlias(ociRes.region)))
+	}
+}
+
 // This function attempts to convert resource data items to a map representation that omits attributes where no value was set.
 func convertDatasourceItemToMap(d *schema.ResourceData, itemPrefix string, itemSchema map[string]*schema.Schema) (map[string]interface{}, error) {
 	result := map[string]interface{}{}
@@ -814,6 +1137,8 @@ func findResourcesGeneric(clients *OracleClients, tfMeta *TerraformResourceAssoc
 	d := datasource.TestResourceData()
 	d.Set("compartment_id", parent.compartmentId)
 
+	referencedParentAttributes := []string{}
+
 	for queryAttributeName, queryValue := range tfMeta.datasourceQueryParams {
 		log.Printf("[INFO] adding datasource query attribute '%s' from parent attribute '%s'\n", queryAttributeName, queryValue)
 		if queryValue == "" || queryValue == "id" {
@@ -822,6 +1147,7 @@ func findResourcesGeneric(clients *OracleClients, tfMeta *TerraformResourceAssoc
 			d.Set(queryAttributeName, queryValue[1:len(queryValue)-1])
 		} else if val, ok := parent.sourceAttributes[queryValue]; ok {
 			d.Set(queryAttributeName, val)
+			referencedParentAttributes = append(referencedParentAttributes, queryValue)
 		} else {
 			log.Printf("[WARN] no attribute '%s' found in parent '%s', returning no results for this resource\n", queryValue, parent.getTerraformReference())
 			return results, nil
@@ -899,6 +1225,7 @@ func findResourcesGeneric(clients *OracleClients, tfMeta *TerraformResourceAssoc
 			if resource.terraformName, err = generateTerraformNameFromResource(resource.sourceAttributes, elemResource.Schema); err != nil {
 				resource.terraformName = fmt.Sprintf("%s_%s_%d", parent.terraformName, tfMeta.resourceAbbreviation, idx+1)
 			}
+			resource.referencedParentAttributes = referencedParentAttributes
 
 			results = append(results, resource)
 		}
@@ -912,6 +1239,7 @@ func findResourcesGeneric(clients *OracleClients, tfMeta *TerraformResourceAssoc
 		if resource.terraformName, err = generateTerraformNameFromResource(resource.sourceAttributes, datasource.Schema); err != nil {
 			resource.terraformName = fmt.Sprintf("%s_%s", parent.terraformName, tfMeta.resourceAbbreviation)
 		}
+		resource.referencedParentAttributes = referencedParentAttributes
 
 		discoverable := true
 		if state, ok := resource.sourceAttributes["state"]; ok && len(tfMeta.discoverableLifecycleStates) > 0 {
@@ -1005,6 +1333,7 @@ func generateOciResourceFromResourceData(d *schema.ResourceData, rawResource int
 		},
 		getHclStringFn: getHclStringFromGenericMap,
 		parent:         parent,
+		region:         parent.region,
 	}
 
 	if tfMeta.getIdFn != nil {
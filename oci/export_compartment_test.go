@@ -1,7 +1,9 @@
 package oci
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strconv"
 	"strings"
@@ -10,7 +12,12 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/backend/local"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/states/statefile"
+	oci_common "github.com/oracle/oci-go-sdk/common"
 )
 
 const (
@@ -897,6 +904,268 @@ func TestUnitGetHCLString_interpolationMap(t *testing.T) {
 	}
 }
 
+// Test that attributes of the parent that a resource was discovered with are replaced with references to the parent
+func TestUnitGetHCLString_parentAttributeReferences(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	rootResource := getRootCompartmentResource()
+
+	resourceGraph := TerraformResourceGraph{
+		"oci_identity_compartment": compartmentTestingResourceGraph["oci_identity_compartment"],
+		"oci_test_parent": {
+			{
+				TerraformResourceHints: exportChildDefinition,
+				datasourceQueryParams:  map[string]string{"parent_id": "id", "a_string": "a_string"},
+			},
+		},
+	}
+	results, err := findResources(nil, rootResource, resourceGraph, nil)
+	assert.Nil(t, err)
+
+	var targetResource *OCIResource
+	for _, resource := range results {
+		if resource.id == getTestResourceId("child", len(childrenResources)-1) {
+			targetResource = resource
+			break
+		}
+	}
+	if !assert.NotNil(t, targetResource) {
+		return
+	}
+	assert.Equal(t, []string{"a_string"}, targetResource.referencedParentAttributes)
+
+	builder := &strings.Builder{}
+	interpolationMap := map[string]string{targetResource.parent.id: targetResource.parent.getHclReferenceIdString()}
+	assert.Nil(t, targetResource.getHCLString(builder, interpolationMap))
+	assert.Contains(t, builder.String(), fmt.Sprintf("a_string = \"${%s.a_string}\"", targetResource.parent.getTerraformReference()))
+
+	// The attributes are not referred to when the parent is not exported
+	builder = &strings.Builder{}
+	assert.Nil(t, targetResource.getHCLString(builder, map[string]string{}))
+	assert.Contains(t, builder.String(), fmt.Sprintf("a_string = %q", targetResource.parent.sourceAttributes["a_string"]))
+}
+
+func TestUnitSortResourcesByReferences(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	rootResource := getRootCompartmentResource()
+
+	results, err := findResources(nil, rootResource, compartmentTestingResourceGraph, nil)
+	assert.Nil(t, err)
+
+	// Discover the children before their parents, the order of the resources in the graph of a service
+	resources := []*OCIResource{}
+	interpolationMap := map[string]string{}
+	for i := len(results) - 1; i >= 0; i-- {
+		resources = append(resources, results[i])
+		interpolationMap[results[i].id] = results[i].getHclReferenceIdString()
+	}
+	resourceHcl := map[*OCIResource]string{}
+	for _, resource := range resources {
+		builder := &strings.Builder{}
+		assert.Nil(t, resource.getHCLString(builder, interpolationMap))
+		resourceHcl[resource] = builder.String()
+	}
+
+	sortedResources := sortResourcesByReferences(resources, resourceHcl)
+	assert.Len(t, sortedResources, len(resources))
+	position := map[*OCIResource]int{}
+	for idx, resource := range sortedResources {
+		position[resource] = idx
+	}
+	for _, resource := range resources {
+		if resource.terraformClass == "oci_test_child" {
+			assert.True(t, position[resource.parent] < position[resource], "%s is before its parent", resource.getTerraformReference())
+		}
+	}
+	// The resources that do not refer to each other keep their order
+	assert.Equal(t, resources[0], sortedResources[1])
+}
+
+func TestUnitExportCommandArgs_services(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	outputDir, err := os.Getwd()
+	assert.Nil(t, err)
+
+	args := &ExportCommandArgs{
+		Services:     []string{"compartment_testing", "tenancy_testing"},
+		ExcludeTypes: []string{"oci_test_child", "oci_core_*"},
+		OutputDir:    &outputDir,
+	}
+	assert.Nil(t, args.validate())
+	assert.True(t, args.isExcluded("oci_test_child"))
+	assert.True(t, args.isExcluded("oci_core_instance"))
+	assert.False(t, args.isExcluded("oci_test_parent"))
+	assert.False(t, args.isExcluded("oci_kms_vault"))
+
+	args.Services = []string{"compartment_testing", "not_a_service"}
+	err = args.validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not_a_service")
+
+	args.Services = []string{"compartment_testing"}
+	args.ExcludeTypes = []string{"oci_core_["}
+	err = args.validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "oci_core_[")
+}
+
+func TestUnitBuildGenerateConfigSteps_regions(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	storeExportConfigProvider := exportConfigProvider
+	defer func() { exportConfigProvider = storeExportConfigProvider }()
+	exportConfigProvider = oci_common.NewRawConfigurationProvider("ocid1.tenancy.1", "ocid1.user.1", "us-phoenix-1", "fingerprint", "", nil)
+	outputDir, err := os.Getwd()
+	assert.Nil(t, err)
+
+	args := &ExportCommandArgs{
+		Services:  []string{"compartment_testing", "tagging", "tenancy_testing"},
+		Regions:   []string{"us-phoenix-1", "us-ashburn-1", "us-phoenix-1"},
+		OutputDir: &outputDir,
+	}
+	assert.Nil(t, args.validate())
+	args.finalizeRegions()
+	assert.Equal(t, []string{"us-ashburn-1", "us-phoenix-1"}, args.Regions)
+
+	// Regional services are discovered in each region, and the others only once with the provider without an alias
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	steps, err := buildGenerateConfigSteps(&compartmentId, args.Services, args.Regions)
+	assert.Nil(t, err)
+	stepRegions := map[string]string{}
+	for _, step := range steps {
+		stepRegions[step.stepName] = step.root.region
+	}
+	assert.Equal(t, map[string]string{
+		"compartment_testing_us-ashburn-1": "us-ashburn-1",
+		"compartment_testing_us-phoenix-1": "us-phoenix-1",
+		"tagging":                          "",
+		"tenancy_testing":                  "",
+	}, stepRegions)
+
+	// Resources discovered in a region are managed by the provider of that region
+	resource := &OCIResource{
+		TerraformResource: TerraformResource{terraformClass: "oci_test_parent", terraformName: "parent1"},
+		sourceAttributes:  map[string]interface{}{"compartment_id": compartmentId},
+		region:            "us-ashburn-1",
+	}
+	builder := &strings.Builder{}
+	assert.Nil(t, resource.getHCLString(builder, nil))
+	assert.True(t, strings.HasPrefix(builder.String(), "resource oci_test_parent parent1 {\nprovider = oci.us_ashburn_1\n"))
+
+	assert.Nil(t, generateProvidersFile(args.Regions, &outputDir))
+	providersOutputFile := fmt.Sprintf("%s%s%s", outputDir, string(os.PathSeparator), providersFile)
+	defer os.Remove(providersOutputFile)
+	providers, err := ioutil.ReadFile(providersOutputFile)
+	assert.Nil(t, err)
+	assert.Contains(t, string(providers), `alias  = "us_ashburn_1"`)
+	assert.Contains(t, string(providers), `region = "us-phoenix-1"`)
+
+	args.Regions = []string{allRegions, "us-phoenix-1"}
+	assert.Error(t, args.validate())
+}
+
+func TestUnitKmsKeyIds(t *testing.T) {
+	vault := &OCIResource{sourceAttributes: map[string]interface{}{"management_endpoint": "https://vault-management.kms.us-phoenix-1.oraclecloud.com"}}
+
+	// Key summaries are refreshed with the management endpoint of their vault
+	summary := &OCIResource{parent: vault, sourceAttributes: map[string]interface{}{"id": "ocid1.key.1"}}
+	id, err := getKmsKeyId(summary)
+	assert.Nil(t, err)
+	assert.Equal(t, "managementEndpoint/https://vault-management.kms.us-phoenix-1.oraclecloud.com/keys/ocid1.key.1", id)
+
+	// and the keys that are read keep their OCID, and are imported with the management endpoint
+	key := &OCIResource{
+		TerraformResource: TerraformResource{id: "ocid1.key.1"},
+		parent:            vault,
+		sourceAttributes:  map[string]interface{}{"management_endpoint": "https://vault-management.kms.us-phoenix-1.oraclecloud.com"},
+	}
+	_, err = getKmsKeyId(key)
+	assert.Error(t, err)
+	keys, err := processKmsKeys(nil, []*OCIResource{key})
+	assert.Nil(t, err)
+	assert.Equal(t, "ocid1.key.1", keys[0].id)
+	assert.Equal(t, "managementEndpoint/https://vault-management.kms.us-phoenix-1.oraclecloud.com/keys/ocid1.key.1", keys[0].getImportId())
+}
+
+func TestUnitGenerateStateFile(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	initTestResources()
+	outputDir, err := os.Getwd()
+	outputDir = fmt.Sprintf("%s%sdiscoveryTest-%d", outputDir, string(os.PathSeparator), time.Now().Nanosecond())
+	if err = os.Mkdir(outputDir, os.ModePerm); err != nil {
+		t.Logf("unable to mkdir %s. err: %v", outputDir, err)
+		t.Fail()
+	}
+	defer os.RemoveAll(outputDir)
+
+	parentId := getTestResourceId("parent", 1)
+	resources := []*OCIResource{
+		{TerraformResource: TerraformResource{id: parentId, terraformClass: "oci_test_parent", terraformName: "parent1"}},
+		{TerraformResource: TerraformResource{id: "ocid1.unknown.1", terraformClass: "oci_test_unknown", terraformName: "unknown1"}},
+	}
+	stateOutputFile := fmt.Sprintf("%s%s%s", outputDir, string(os.PathSeparator), local.DefaultStateFilename)
+	tmpStateOutputFile := fmt.Sprintf("%s%s%s", outputDir, string(os.PathSeparator), defaultTmpStateFile)
+	assert.Nil(t, generateStateFile(nil, resources, stateOutputFile, tmpStateOutputFile))
+
+	_, err = os.Stat(tmpStateOutputFile)
+	assert.True(t, os.IsNotExist(err))
+
+	file, err := os.Open(stateOutputFile)
+	assert.Nil(t, err)
+	defer file.Close()
+	stateFile, err := statefile.Read(file)
+	assert.Nil(t, err)
+
+	// Only the resources that can be imported are in the state, with the attributes they are read with
+	module := stateFile.State.RootModule()
+	assert.Len(t, module.Resources, 1)
+	parentAddr := addrs.Resource{Mode: addrs.ManagedResourceMode, Type: "oci_test_parent", Name: "parent1"}.Instance(addrs.NoKey)
+	parent := module.ResourceInstance(parentAddr)
+	if assert.NotNil(t, parent) && assert.NotNil(t, parent.Current) {
+		assert.Equal(t, states.ObjectReady, parent.Current.Status)
+		attributes := map[string]interface{}{}
+		assert.Nil(t, json.Unmarshal(parent.Current.AttrsJSON, &attributes))
+		assert.Equal(t, parentId, attributes["id"])
+		assert.Equal(t, parentResources[parentId]["compartment_id"], attributes["compartment_id"])
+	}
+	assert.Equal(t, "provider.oci", module.Resource(parentAddr.Resource).ProviderConfig.String())
+}
+
+func TestUnitGenerateImportScript(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	outputDir, err := os.Getwd()
+	outputDir = fmt.Sprintf("%s%sdiscoveryTest-%d", outputDir, string(os.PathSeparator), time.Now().Nanosecond())
+	if err = os.Mkdir(outputDir, os.ModePerm); err != nil {
+		t.Logf("unable to mkdir %s. err: %v", outputDir, err)
+		t.Fail()
+	}
+	defer os.RemoveAll(outputDir)
+
+	resources := []*OCIResource{
+		{TerraformResource: TerraformResource{id: "ocid1.parent.1", terraformClass: "oci_test_parent", terraformName: "parent1"}},
+		{TerraformResource: TerraformResource{id: "ocid1.bucket.1", importId: "n/namespace/b/it's", terraformClass: "oci_objectstorage_bucket", terraformName: "bucket1"}},
+		{TerraformResource: TerraformResource{id: "ocid1.unknown.1", terraformClass: "oci_test_unknown", terraformName: "unknown1"}},
+	}
+	assert.Nil(t, generateImportScript(resources, &outputDir))
+
+	scriptFile := fmt.Sprintf("%s%s%s", outputDir, string(os.PathSeparator), importScriptFile)
+	info, err := os.Stat(scriptFile)
+	assert.Nil(t, err)
+	assert.NotZero(t, info.Mode()&0100, "the import script should be executable")
+
+	script, err := ioutil.ReadFile(scriptFile)
+	assert.Nil(t, err)
+	assert.Contains(t, string(script), "terraform import 'oci_test_parent.parent1' 'ocid1.parent.1'\n")
+	// Composite import identifiers are used instead of the OCID and are quoted for the shell
+	assert.Contains(t, string(script), `terraform import 'oci_objectstorage_bucket.bucket1' 'n/namespace/b/it'\''s'`+"\n")
+	// Resources that cannot be imported are left out
+	assert.Contains(t, string(script), "# 'oci_test_unknown.unknown1' cannot be imported\n")
+}
+
 func Test_getExportConfig(t *testing.T) {
 
 	providerConfigTest(t, true, true, authAPIKeySetting, "", getExportConfig)              // ApiKey with required fields + disable auto-retries
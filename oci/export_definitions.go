@@ -5,6 +5,7 @@ import (
 	oci_core "github.com/oracle/oci-go-sdk/core"
 	oci_database "github.com/oracle/oci-go-sdk/database"
 	oci_identity "github.com/oracle/oci-go-sdk/identity"
+	oci_kms "github.com/oracle/oci-go-sdk/keymanagement"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/loadbalancer"
 )
 
@@ -519,6 +520,26 @@ var exportIdentityTagHints = &TerraformResourceHints{
 	},
 }
 
+var exportKmsKeyHints = &TerraformResourceHints{
+	resourceClass:        "oci_kms_key",
+	datasourceClass:      "oci_kms_keys",
+	datasourceItemsAttr:  "keys",
+	resourceAbbreviation: "key",
+	discoverableLifecycleStates: []string{
+		string(oci_kms.KeyLifecycleStateEnabled),
+	},
+}
+
+var exportKmsVaultHints = &TerraformResourceHints{
+	resourceClass:        "oci_kms_vault",
+	datasourceClass:      "oci_kms_vaults",
+	datasourceItemsAttr:  "vaults",
+	resourceAbbreviation: "vault",
+	discoverableLifecycleStates: []string{
+		string(oci_kms.VaultLifecycleStateActive),
+	},
+}
+
 var exportLoadBalancerBackendHints = &TerraformResourceHints{
 	resourceClass:        "oci_load_balancer_backend",
 	datasourceClass:      "oci_load_balancer_backends",
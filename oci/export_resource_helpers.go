@@ -96,6 +96,10 @@ func init() {
 	exportIdentityTagHints.findResourcesOverrideFn = findIdentityTags
 	exportIdentityTagHints.processDiscoveredResourcesFn = processTagDefinitions
 
+	exportKmsKeyHints.getIdFn = getKmsKeyId
+	exportKmsKeyHints.requireResourceRefresh = true
+	exportKmsKeyHints.processDiscoveredResourcesFn = processKmsKeys
+
 	exportObjectStorageBucketHints.getIdFn = getObjectStorageBucketId
 	exportObjectStorageBucketHints.requireResourceRefresh = true
 }
@@ -108,12 +112,18 @@ var compartmentResourceGraphs = map[string]TerraformResourceGraph{
 	"bds":                 bdsResourceGraph,
 	"core":                coreResourceGraph,
 	"database":            databaseResourceGraph,
+	"kms":                 kmsResourceGraph,
 	"load_balancer":       loadBalancerResourceGraph,
 	"tagging":             taggingResourceGraph,
 	"availability_domain": availabilityDomainsGraph,
 	"object_storage":      objectStorageResourceGraph,
 }
 
+// Compartment-scope services whose resources are not regional, they are discovered once rather than in each region
+var globalCompartmentServices = map[string]bool{
+	"tagging": true,
+}
+
 var taggingResourceGraph = TerraformResourceGraph{
 	"oci_identity_compartment": {
 		{TerraformResourceHints: exportIdentityTagNamespaceHints},
@@ -358,6 +368,20 @@ var objectStorageResourceGraph = TerraformResourceGraph{
 	},
 }
 
+var kmsResourceGraph = TerraformResourceGraph{
+	"oci_identity_compartment": {
+		{TerraformResourceHints: exportKmsVaultHints},
+	},
+	"oci_kms_vault": {
+		{
+			TerraformResourceHints: exportKmsKeyHints,
+			datasourceQueryParams: map[string]string{
+				"management_endpoint": "management_endpoint",
+			},
+		},
+	},
+}
+
 var bdsResourceGraph = TerraformResourceGraph{
 	"oci_identity_compartment": {
 		{TerraformResourceHints: exportBdsBdsInstanceHints},
@@ -503,6 +527,7 @@ func processObjectStorageNamespace(clients *OracleClients, resources []*OCIResou
 
 func getAvailabilityDomainHCLDatasource(builder *strings.Builder, ociRes *OCIResource, varMap map[string]string) error {
 	builder.WriteString(fmt.Sprintf("data %s %s {\n", ociRes.terraformClass, ociRes.terraformName))
+	ociRes.writeProviderAlias(builder)
 
 	builder.WriteString(fmt.Sprintf("compartment_id = \"%s\"\n", varMap[ociRes.compartmentId]))
 
@@ -518,6 +543,7 @@ func getAvailabilityDomainHCLDatasource(builder *strings.Builder, ociRes *OCIRes
 
 func getObjectStorageNamespaceHCLDatasource(builder *strings.Builder, ociRes *OCIResource, varMap map[string]string) error {
 	builder.WriteString(fmt.Sprintf("data %s %s {\n", ociRes.terraformClass, ociRes.terraformName))
+	ociRes.writeProviderAlias(builder)
 	builder.WriteString(fmt.Sprintf("compartment_id = \"%s\"\n", varMap[ociRes.compartmentId]))
 	builder.WriteString("}\n")
 
@@ -646,6 +672,41 @@ func getObjectStorageBucketId(resource *OCIResource) (string, error) {
 	return getBucketCompositeId(name, namespace), nil
 }
 
+// Keys are read with the management endpoint of their vault, in the identifier they are imported with. The key
+// summaries returned by the data source have an id, the keys that are read with this identifier do not, and keep
+// their OCID as their id.
+func getKmsKeyId(resource *OCIResource) (string, error) {
+	id, ok := resource.sourceAttributes["id"].(string)
+	if !ok {
+		return "", fmt.Errorf("[ERROR] unable to find id for key")
+	}
+
+	if resource.parent == nil {
+		return "", fmt.Errorf("[ERROR] unable to find the vault of key '%s'", id)
+	}
+	managementEndpoint, ok := resource.parent.sourceAttributes["management_endpoint"].(string)
+	if !ok {
+		return "", fmt.Errorf("[ERROR] unable to find management endpoint for key '%s'", id)
+	}
+
+	return getKmsKeyCompositeId(managementEndpoint, id), nil
+}
+
+func getKmsKeyCompositeId(managementEndpoint string, keyId string) string {
+	return fmt.Sprintf("managementEndpoint/%s/keys/%s", managementEndpoint, keyId)
+}
+
+func processKmsKeys(clients *OracleClients, resources []*OCIResource) ([]*OCIResource, error) {
+	for _, resource := range resources {
+		managementEndpoint, ok := resource.sourceAttributes["management_endpoint"].(string)
+		if !ok {
+			return resources, fmt.Errorf("[ERROR] unable to find management endpoint for key '%s'", resource.id)
+		}
+		resource.importId = getKmsKeyCompositeId(managementEndpoint, resource.id)
+	}
+	return resources, nil
+}
+
 func findIdentityTags(clients *OracleClients, tfMeta *TerraformResourceAssociation, parent *OCIResource) ([]*OCIResource, error) {
 	// List on Tags does not return validator, and resource Read requires tagNamespaceId
 	// which is also not returned in Summary response. Tags also do not have composite id in state.
@@ -697,6 +758,7 @@ func findIdentityTags(clients *OracleClients, tfMeta *TerraformResourceAssociati
 			},
 			getHclStringFn: getHclStringFromGenericMap,
 			parent:         parent,
+			region:         parent.region,
 		}
 
 		results = append(results, resource)
@@ -756,6 +818,7 @@ func findLoadBalancerListeners(clients *OracleClients, tfMeta *TerraformResource
 			},
 			getHclStringFn: getHclStringFromGenericMap,
 			parent:         parent,
+			region:         parent.region,
 		}
 
 		if !parent.omitFromExport {
@@ -5,8 +5,11 @@ package oci
 import (
 	"context"
 	"fmt"
+	"log"
+	"net"
 	"strconv"
 
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/schema"
 
 	oci_file_storage "github.com/oracle/oci-go-sdk/filestorage"
@@ -103,7 +106,81 @@ func FileStorageExportResource() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		// The service evaluates export options in order, so overlapping sources are allowed but
+		// an option whose source is covered by an earlier one never takes effect
+		CustomizeDiff: customdiff.All(
+			warnShadowedExportOptions,
+		),
+	}
+}
+
+// The warning only goes to the Terraform log, since the plugin SDK has no way to show warnings in the plan output
+func warnShadowedExportOptions(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("export_options") || !d.NewValueKnown("export_options") {
+		return nil
+	}
+
+	exportOptions := d.Get("export_options").([]interface{})
+	sources := make([]string, len(exportOptions))
+	for i, exportOption := range exportOptions {
+		if exportOptionMap, ok := exportOption.(map[string]interface{}); ok {
+			if source, ok := exportOptionMap["source"].(string); ok {
+				sources[i] = source
+			}
+		}
+	}
+
+	for index, shadowedBy := range getShadowedExportOptionSources(sources) {
+		log.Printf("[WARN] export_options.%d with source '%s' is covered by the source '%s' of export_options.%d and will never be applied", index, sources[index], sources[shadowedBy], shadowedBy)
+	}
+
+	return nil
+}
+
+// Returns a map from the index of each source that is fully covered by a preceding source,
+// to the index of the first preceding source covering it. Sources that are neither an IP
+// address nor a CIDR block are ignored.
+func getShadowedExportOptionSources(sources []string) map[int]int {
+	networks := make([]*net.IPNet, len(sources))
+	for i, source := range sources {
+		networks[i] = parseExportOptionSource(source)
+	}
+
+	result := map[int]int{}
+	for i, network := range networks {
+		if network == nil {
+			continue
+		}
+		ones, bits := network.Mask.Size()
+		for j := 0; j < i; j++ {
+			if networks[j] == nil {
+				continue
+			}
+			earlierOnes, earlierBits := networks[j].Mask.Size()
+			if earlierBits == bits && earlierOnes <= ones && networks[j].Contains(network.IP) {
+				result[i] = j
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+func parseExportOptionSource(source string) *net.IPNet {
+	if _, network, err := net.ParseCIDR(source); err == nil {
+		return network
+	}
+
+	ip := net.ParseIP(source)
+	if ip == nil {
+		return nil
+	}
+	if ipv4 := ip.To4(); ipv4 != nil {
+		return &net.IPNet{IP: ipv4, Mask: net.CIDRMask(32, 32)}
 	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}
 }
 
 func createFileStorageExport(d *schema.ResourceData, m interface{}) error {
@@ -308,3 +308,31 @@ func exportSweepResponseFetchOperation(client *OracleClients, resourceId *string
 	})
 	return err
 }
+
+func TestUnitFileStorageExport_getShadowedExportOptionSources(t *testing.T) {
+	tests := []struct {
+		sources  []string
+		expected map[int]int
+	}{
+		{[]string{"10.0.0.0/16", "10.1.0.0/16"}, map[int]int{}},
+		{[]string{"10.0.0.0/16", "10.0.1.0/24"}, map[int]int{1: 0}},
+		{[]string{"10.0.1.0/24", "10.0.0.0/16"}, map[int]int{}},
+		{[]string{"0.0.0.0/0", "10.0.0.5", "10.0.0.0/8"}, map[int]int{1: 0, 2: 0}},
+		{[]string{"10.0.0.5", "10.0.0.5/32"}, map[int]int{1: 0}},
+		{[]string{"fd00::/8", "fd00::1", "10.0.0.1"}, map[int]int{1: 0}},
+		{[]string{"@netgroup", "10.0.0.0/8"}, map[int]int{}},
+	}
+
+	for _, test := range tests {
+		result := getShadowedExportOptionSources(test.sources)
+		if len(result) != len(test.expected) {
+			t.Errorf("expected %v shadowed sources for %v, got %v", test.expected, test.sources, result)
+			continue
+		}
+		for index, shadowedBy := range test.expected {
+			if result[index] != shadowedBy {
+				t.Errorf("expected %v shadowed sources for %v, got %v", test.expected, test.sources, result)
+			}
+		}
+	}
+}
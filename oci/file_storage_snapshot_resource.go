@@ -158,7 +158,7 @@ func (s *FileStorageSnapshotResourceCrud) Create() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage", snapshotService)
 
 	response, err := s.Client.CreateSnapshot(context.Background(), request)
 	if err != nil {
@@ -221,7 +221,7 @@ func (s *FileStorageSnapshotResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SnapshotId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage", snapshotService)
 
 	_, err := s.Client.DeleteSnapshot(context.Background(), request)
 	return err
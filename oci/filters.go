@@ -6,12 +6,14 @@ import (
 	"log"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 
 	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func dataSourceFiltersSchema() *schema.Schema {
@@ -49,6 +51,130 @@ var PrimitiveDataTypes = map[schema.ValueType]bool{
 	schema.TypeInt:    true,
 }
 
+// addListSortAndLimit adds sort_by, sort_order and limit arguments to a list data source, i.e. a data source with
+// a filter argument, to sort the listed items by one of their attributes and to keep only the first of them. Items
+// are sorted after they are listed and filtered, since few of the list operations support sorting by more than
+// the time created and the display name.
+// Data sources that already have a sort_by or limit argument pass it to the service, and do not get the argument or
+// have their items sorted or limited again.
+func addListSortAndLimit(datasource *schema.Resource) {
+	if datasource == nil || datasource.Schema == nil || datasource.Schema["filter"] == nil {
+		return
+	}
+
+	itemsAttrName := ""
+	var itemSchema map[string]*schema.Schema
+	for name, fieldSchema := range datasource.Schema {
+		if elemResource, ok := fieldSchema.Elem.(*schema.Resource); ok && fieldSchema.Type == schema.TypeList && fieldSchema.Computed && !fieldSchema.Optional {
+			if itemsAttrName != "" {
+				return
+			}
+			itemsAttrName = name
+			itemSchema = elemResource.Schema
+		}
+	}
+	if itemsAttrName == "" {
+		return
+	}
+
+	sortItems := false
+	if _, ok := datasource.Schema["sort_by"]; !ok {
+		var sortableAttrNames []string
+		for name, fieldSchema := range itemSchema {
+			if PrimitiveDataTypes[fieldSchema.Type] {
+				sortableAttrNames = append(sortableAttrNames, name)
+			}
+		}
+		sort.Strings(sortableAttrNames)
+
+		datasource.Schema["sort_by"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(sortableAttrNames, false),
+		}
+		datasource.Schema["sort_order"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"ASC", "DESC"}, false),
+		}
+		sortItems = true
+	}
+
+	limitItems := false
+	if _, ok := datasource.Schema["limit"]; !ok {
+		datasource.Schema["limit"] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		}
+		limitItems = true
+	}
+
+	datasource.Read = withListSortAndLimit(datasource.Read, itemsAttrName, sortItems, limitItems)
+}
+
+func withListSortAndLimit(fn func(*schema.ResourceData, interface{}) error, itemsAttrName string, sortItems bool, limitItems bool) func(*schema.ResourceData, interface{}) error {
+	if fn == nil || (!sortItems && !limitItems) {
+		return fn
+	}
+	return func(d *schema.ResourceData, m interface{}) error {
+		if err := fn(d, m); err != nil {
+			return err
+		}
+
+		items, _ := d.Get(itemsAttrName).([]interface{})
+		changed := false
+
+		if sortBy, ok := d.GetOk("sort_by"); ok && sortItems {
+			descending := d.Get("sort_order").(string) == "DESC"
+			sort.SliceStable(items, func(i, j int) bool {
+				iItem, _ := items[i].(map[string]interface{})
+				jItem, _ := items[j].(map[string]interface{})
+				if descending {
+					return lessSortValue(jItem[sortBy.(string)], iItem[sortBy.(string)])
+				}
+				return lessSortValue(iItem[sortBy.(string)], jItem[sortBy.(string)])
+			})
+			changed = true
+		}
+
+		if limit, ok := d.GetOk("limit"); ok && limitItems && len(items) > limit.(int) {
+			items = items[:limit.(int)]
+			changed = true
+		}
+
+		if !changed {
+			return nil
+		}
+		return d.Set(itemsAttrName, items)
+	}
+}
+
+// lessSortValue orders the values of an item attribute. Strings that are numbers, like the sizes that are set as
+// strings to avoid overflows, are ordered by their value.
+func lessSortValue(a interface{}, b interface{}) bool {
+	switch aVal := a.(type) {
+	case int:
+		bVal, _ := b.(int)
+		return aVal < bVal
+	case float64:
+		bVal, _ := b.(float64)
+		return aVal < bVal
+	case bool:
+		bVal, _ := b.(bool)
+		return !aVal && bVal
+	case string:
+		bVal, _ := b.(string)
+		aFloat, aErr := strconv.ParseFloat(aVal, 64)
+		bFloat, bErr := strconv.ParseFloat(bVal, 64)
+		if aErr == nil && bErr == nil {
+			return aFloat < bFloat
+		}
+		return aVal < bVal
+	}
+	return false
+}
+
 // Process an entity's properties (string or array of strings) by N filter sets of
 // keyword:values, where each filter set ANDs and each keyword:values set ORs
 func ApplyFilters(filters *schema.Set, items []map[string]interface{}, resourceSchema map[string]*schema.Schema) []map[string]interface{} {
@@ -90,9 +216,12 @@ func ApplyFilters(filters *schema.Set, items []map[string]interface{}, resourceS
 		// build a collection of items from matches against the set of filters
 		res := make([]map[string]interface{}, 0)
 		for _, item := range items {
-			targetVal, targetValOk := getValueFromPath(item, pathElements)
-			if targetValOk && orComparator(targetVal, fSet["values"].([]interface{}), stringsEqual) {
-				res = append(res, item)
+			// a path through a list of nested structures has a value for each of them, and matches if any of them does
+			for _, targetVal := range getValuesFromPath(item, pathElements) {
+				if orComparator(targetVal, fSet["values"].([]interface{}), stringsEqual) {
+					res = append(res, item)
+					break
+				}
 			}
 		}
 		items = res
@@ -102,26 +231,46 @@ func ApplyFilters(filters *schema.Set, items []map[string]interface{}, resourceS
 }
 
 func getValueFromPath(item map[string]interface{}, path []string) (targetVal interface{}, targetValOk bool) {
-	workingMap := item
-	tempWorkingMap := item
-	var conversionOk bool
+	targetVals := getValuesFromPath(item, path)
+	if len(targetVals) != 1 {
+		return nil, false
+	}
+	return targetVals[0], true
+}
+
+// getValuesFromPath returns the values at the end of the path, one for each element of the lists of nested structures
+// that the path goes through
+func getValuesFromPath(item map[string]interface{}, path []string) []interface{} {
+	workingMaps := []map[string]interface{}{item}
 	for _, pathElement := range path[:len(path)-1] {
-		// Defensive check for non existent values
-		if workingMap[pathElement] == nil {
-			return nil, false
-		}
-		// Check if it is map
-		if tempWorkingMap, conversionOk = checkAndConvertMap(workingMap[pathElement]); !conversionOk {
-			// if not map then it has to be a nested structure which is modeled as list with exactly one element of type map[string]interface{}
-			if tempWorkingMap, conversionOk = checkAndConvertNestedStructure(workingMap[pathElement]); !conversionOk {
-				return nil, false
+		var nextWorkingMaps []map[string]interface{}
+		for _, workingMap := range workingMaps {
+			// Defensive check for non existent values
+			if workingMap[pathElement] == nil {
+				continue
+			}
+			// Check if it is map
+			if tempWorkingMap, conversionOk := checkAndConvertMap(workingMap[pathElement]); conversionOk {
+				nextWorkingMaps = append(nextWorkingMaps, tempWorkingMap)
+				continue
 			}
+			// if not map then it has to be a nested structure which is modeled as a list of elements of type map[string]interface{}
+			nextWorkingMaps = append(nextWorkingMaps, checkAndConvertNestedStructures(workingMap[pathElement])...)
 		}
-		workingMap = tempWorkingMap
+		workingMaps = nextWorkingMaps
 	}
 
-	targetVal, targetValOk = workingMap[path[len(path)-1]]
-	return
+	var targetVals []interface{}
+	for _, workingMap := range workingMaps {
+		targetVal := workingMap[path[len(path)-1]]
+		if set, isSet := targetVal.(*schema.Set); isSet {
+			targetVal = set.List()
+		}
+		if targetVal != nil {
+			targetVals = append(targetVals, targetVal)
+		}
+	}
+	return targetVals
 }
 
 func checkAndConvertMap(element interface{}) (map[string]interface{}, bool) {
@@ -145,13 +294,24 @@ func convertToObjectMap(stringTostring map[string]string) map[string]interface{}
 	return convertedMap
 }
 
-func checkAndConvertNestedStructure(element interface{}) (map[string]interface{}, bool) {
-	if convertedList, convertedListOk := element.([]interface{}); convertedListOk && len(convertedList) == 1 {
-		workingMap, isOk := convertedList[0].(map[string]interface{})
-		return workingMap, isOk
+func checkAndConvertNestedStructures(element interface{}) []map[string]interface{} {
+	if set, isSet := element.(*schema.Set); isSet {
+		element = set.List()
 	}
 
-	return nil, false
+	if convertedList, convertedListOk := element.([]map[string]interface{}); convertedListOk {
+		return convertedList
+	}
+
+	var workingMaps []map[string]interface{}
+	if convertedList, convertedListOk := element.([]interface{}); convertedListOk {
+		for _, convertedElement := range convertedList {
+			if workingMap, isOk := convertedElement.(map[string]interface{}); isOk {
+				workingMaps = append(workingMaps, workingMap)
+			}
+		}
+	}
+	return workingMaps
 }
 
 //Converts the filter name which is delimited by '.' into a list of XPath elements
@@ -218,7 +378,7 @@ func isValidSchemaType(fieldSchema *schema.Schema) bool {
 	if fieldSchema.Type == schema.TypeList || fieldSchema.Type == schema.TypeSet {
 		if elemSchema, conversionOk := fieldSchema.Elem.(*schema.Schema); conversionOk && elemSchema.Type == schema.TypeString {
 			return true
-		} else if fieldSchema.MaxItems == 1 && fieldSchema.MinItems == 1 { //nested structures
+		} else if _, conversionOk := fieldSchema.Elem.(*schema.Resource); conversionOk { //nested structures
 			return true
 		}
 		return false
@@ -229,6 +389,40 @@ func isValidSchemaType(fieldSchema *schema.Schema) bool {
 
 type StringCheck func(propertyVal string, filterVal string) bool
 
+// Comparison operators that can prefix the filter values of number properties, e.g. ">= 4"
+var numberComparisonOperators = []string{">=", "<=", "!=", ">", "<", "="}
+
+// parseNumberComparison splits a filter value of a number property into its comparison operator and the number
+// to compare with. Values without an operator are compared for equality.
+func parseNumberComparison(filterVal string) (operator string, operand string) {
+	filterVal = strings.TrimSpace(filterVal)
+	for _, operator := range numberComparisonOperators {
+		if strings.HasPrefix(filterVal, operator) {
+			return operator, strings.TrimSpace(strings.TrimPrefix(filterVal, operator))
+		}
+	}
+	return "=", filterVal
+}
+
+// compareNumbers applies a comparison operator to the result of comparing a property with a filter value,
+// where cmp is negative, zero or positive when the property is less than, equal to or greater than the value
+func compareNumbers(cmp int, operator string) bool {
+	switch operator {
+	case ">=":
+		return cmp >= 0
+	case "<=":
+		return cmp <= 0
+	case "!=":
+		return cmp != 0
+	case ">":
+		return cmp > 0
+	case "<":
+		return cmp < 0
+	default:
+		return cmp == 0
+	}
+}
+
 // orComparator returns true for any filter that matches the target property
 func orComparator(target interface{}, filters []interface{}, stringsEqual StringCheck) bool {
 	// Use reflection to determine whether the underlying type of the filtering attribute is a string or
@@ -247,25 +441,39 @@ func orComparator(target interface{}, filters []interface{}, stringsEqual String
 			if val.Bool() == fBool {
 				return true
 			}
-		case reflect.Int:
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 			// the target field is of type int, but the filter values list element type is string, users can supply string
 			// or int like `values = [300, "3600"]` but terraform will converts to string, so use ParseInt
-			fInt, err := strconv.ParseInt(fVal.(string), 10, 64)
+			operator, operand := parseNumberComparison(fVal.(string))
+			fInt, err := strconv.ParseInt(operand, 10, 64)
 			if err != nil {
 				log.Println("[WARN] Filtering against Type Int field with non-int filter value")
 				return false
 			}
-			if val.Int() == fInt {
+			cmp := 0
+			if val.Int() < fInt {
+				cmp = -1
+			} else if val.Int() > fInt {
+				cmp = 1
+			}
+			if compareNumbers(cmp, operator) {
 				return true
 			}
-		case reflect.Float64:
+		case reflect.Float32, reflect.Float64:
 			// same comment as above for Ints
-			fFloat, err := strconv.ParseFloat(fVal.(string), 64)
+			operator, operand := parseNumberComparison(fVal.(string))
+			fFloat, err := strconv.ParseFloat(operand, 64)
 			if err != nil {
 				log.Println("[WARN] Filtering against Type Float field with non-float filter value")
 				return false
 			}
-			if val.Float() == fFloat {
+			cmp := 0
+			if val.Float() < fFloat {
+				cmp = -1
+			} else if val.Float() > fFloat {
+				cmp = 1
+			}
+			if compareNumbers(cmp, operator) {
 				return true
 			}
 		case reflect.String:
@@ -280,6 +488,14 @@ func orComparator(target interface{}, filters []interface{}, stringsEqual String
 						return true
 					}
 				}
+			} else if valType.Elem().Kind() == reflect.Interface {
+				// lists of strings set by SetData are usually of type []interface{}
+				arrLen := val.Len()
+				for i := 0; i < arrLen; i++ {
+					if elem, isString := val.Index(i).Interface().(string); isString && stringsEqual(elem, fVal.(string)) {
+						return true
+					}
+				}
 			}
 		}
 	}
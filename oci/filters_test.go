@@ -582,6 +582,132 @@ func TestUnitApplyFilters_numberTypes(t *testing.T) {
 	filters.Remove(floatFilter)
 }
 
+func TestUnitApplyFilters_numberComparisons(t *testing.T) {
+	items := []map[string]interface{}{
+		{
+			"integer": 1,
+			"float":   float32(1.5),
+		},
+		{
+			"integer": 2,
+			"float":   float32(2.5),
+		},
+		{
+			"integer": 3,
+			"float":   float32(3.5),
+		},
+	}
+
+	testSchema := map[string]*schema.Schema{
+		"integer": {
+			Type: schema.TypeInt,
+		},
+		"float": {
+			Type: schema.TypeFloat,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		values   []interface{}
+		expected int
+	}{
+		{"integer", []interface{}{">= 2"}, 2},
+		{"integer", []interface{}{">2"}, 1},
+		{"integer", []interface{}{"<= 2"}, 2},
+		{"integer", []interface{}{"< 2"}, 1},
+		{"integer", []interface{}{"!= 2"}, 2},
+		{"integer", []interface{}{"= 2"}, 1},
+		{"integer", []interface{}{"< 2", "> 2"}, 2},
+		{"integer", []interface{}{"> two"}, 0},
+		{"float", []interface{}{"2.5"}, 1},
+		{"float", []interface{}{"> 2"}, 2},
+		{"float", []interface{}{"<= 1.5"}, 1},
+	}
+
+	for _, test := range tests {
+		filters := &schema.Set{F: func(interface{}) int { return 1 }}
+		filters.Add(map[string]interface{}{
+			"name":   test.name,
+			"values": test.values,
+		})
+
+		res := ApplyFilters(filters, items, testSchema)
+		if len(res) != test.expected {
+			t.Errorf("Expected %d results for %s %v, got %d", test.expected, test.name, test.values, len(res))
+		}
+	}
+}
+
+// Filters on a property of a list of nested structures match items for which any element of the list matches
+func TestUnitApplyFilters_listOfNestedStructures(t *testing.T) {
+	items := []map[string]interface{}{
+		{
+			"route_rules": []interface{}{
+				map[string]interface{}{"destination": "0.0.0.0/0", "network_entity_id": "ig1"},
+				map[string]interface{}{"destination": "10.0.0.0/16", "network_entity_id": "drg1"},
+			},
+		},
+		{
+			"route_rules": []interface{}{
+				map[string]interface{}{"destination": "0.0.0.0/0", "network_entity_id": "ig2"},
+			},
+		},
+		{
+			"route_rules": []interface{}{},
+		},
+	}
+
+	filters := &schema.Set{F: func(interface{}) int { return 1 }}
+	filters.Add(map[string]interface{}{
+		"name":   "route_rules.network_entity_id",
+		"values": []interface{}{"drg1"},
+	})
+
+	res := ApplyFilters(filters, items, CoreRouteTableResource().Schema)
+	if len(res) != 1 {
+		t.Errorf("Expected 1 result, got %d", len(res))
+	}
+
+	filters = &schema.Set{F: func(interface{}) int { return 1 }}
+	filters.Add(map[string]interface{}{
+		"name":   "route_rules.destination",
+		"values": []interface{}{"0.0.0.0/0"},
+	})
+
+	res = ApplyFilters(filters, items, CoreRouteTableResource().Schema)
+	if len(res) != 2 {
+		t.Errorf("Expected 2 results, got %d", len(res))
+	}
+}
+
+// Filters should test against lists of strings of type []interface{} and sets
+func TestUnitApplyFilters_interfaceListsAndSets(t *testing.T) {
+	items := []map[string]interface{}{
+		{"letters": []interface{}{"a", "b"}},
+		{"letters": schema.NewSet(schema.HashString, []interface{}{"c", "d"})},
+		{"letters": nil},
+	}
+
+	testSchema := map[string]*schema.Schema{
+		"letters": {
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{Type: schema.TypeString},
+		},
+	}
+
+	filters := &schema.Set{F: func(interface{}) int { return 1 }}
+	filters.Add(map[string]interface{}{
+		"name":   "letters",
+		"values": []interface{}{"b", "c"},
+	})
+
+	res := ApplyFilters(filters, items, testSchema)
+	if len(res) != 2 {
+		t.Errorf("Expected 2 results, got %d", len(res))
+	}
+}
+
 func TestUnitApplyFilters_multiProperty(t *testing.T) {
 	items := []map[string]interface{}{
 		{
@@ -748,6 +874,12 @@ func TestUnitGetPathElements_MultiLevelNonMap(t *testing.T) {
 	}
 }
 
+func TestUnitGetPathElements_ListOfNestedStructures(t *testing.T) {
+	if path, error := getFieldPathElements(CoreRouteTableResource().Schema, "route_rules.network_entity_id"); error != nil || !reflect.DeepEqual(path, []string{"route_rules", "network_entity_id"}) {
+		t.Errorf("unexpected path value %s found", path)
+	}
+}
+
 func TestUnitNestedMap(t *testing.T) {
 	item := map[string]interface{}{
 		"level1": map[string]interface{}{
@@ -766,3 +898,98 @@ func TestUnitNestedMap(t *testing.T) {
 		t.Errorf("unexpected number of values returned in map")
 	}
 }
+
+func TestUnitAddListSortAndLimit(t *testing.T) {
+	listed := []interface{}{
+		map[string]interface{}{"display_name": "b", "size_in_gbs": "100"},
+		map[string]interface{}{"display_name": "c", "size_in_gbs": "20"},
+		map[string]interface{}{"display_name": "a", "size_in_gbs": "3"},
+	}
+	listRead := func(d *schema.ResourceData, m interface{}) error {
+		d.SetId("id")
+		return d.Set("items", listed)
+	}
+	datasource := &schema.Resource{
+		Read: listRead,
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+			"items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size_in_gbs": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+	addListSortAndLimit(datasource)
+	if err := datasource.InternalValidate(nil, false); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	read := func(config map[string]interface{}) []string {
+		d := schema.TestResourceDataRaw(t, datasource.Schema, config)
+		if err := datasource.Read(d, nil); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		var names []string
+		for _, item := range d.Get("items").([]interface{}) {
+			names = append(names, item.(map[string]interface{})["display_name"].(string))
+		}
+		return names
+	}
+
+	tests := []struct {
+		config   map[string]interface{}
+		expected []string
+	}{
+		{map[string]interface{}{}, []string{"b", "c", "a"}},
+		{map[string]interface{}{"sort_by": "display_name"}, []string{"a", "b", "c"}},
+		{map[string]interface{}{"sort_by": "display_name", "sort_order": "DESC"}, []string{"c", "b", "a"}},
+		{map[string]interface{}{"sort_by": "size_in_gbs"}, []string{"a", "c", "b"}},
+		{map[string]interface{}{"sort_by": "display_name", "limit": 2}, []string{"a", "b"}},
+		{map[string]interface{}{"limit": 5}, []string{"b", "c", "a"}},
+	}
+	for _, test := range tests {
+		if names := read(test.config); !reflect.DeepEqual(names, test.expected) {
+			t.Errorf("Expected %v for %v, got %v", test.expected, test.config, names)
+		}
+	}
+
+	// Data sources with a sort_by argument of their own only get the limit argument
+	images := CoreImagesDataSource()
+	sortBy := images.Schema["sort_by"]
+	addListSortAndLimit(images)
+	if images.Schema["sort_by"] != sortBy || images.Schema["limit"] == nil {
+		t.Errorf("Expected only the limit argument to be added")
+	}
+
+	// Data sources with a limit argument of their own pass it to the service, and their items are not limited again
+	datasource = &schema.Resource{
+		Read: listRead,
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+			"items":  datasource.Schema["items"],
+			"limit": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	}
+	addListSortAndLimit(datasource)
+	if names := read(map[string]interface{}{"limit": 1}); !reflect.DeepEqual(names, []string{"b", "c", "a"}) {
+		t.Errorf("Expected the items not to be limited, got %v", names)
+	}
+	if names := read(map[string]interface{}{"sort_by": "display_name", "limit": 1}); !reflect.DeepEqual(names, []string{"a", "b", "c"}) {
+		t.Errorf("Expected the items to be sorted and not limited, got %v", names)
+	}
+}
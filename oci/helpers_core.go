@@ -159,20 +159,86 @@ func getSubnetExpectedRetryDuration(response oci_common.OCIOperationResponse, di
 	return defaultRetryTime
 }
 
-// This before suppression is required because
-//`fd00:aaaa:0123::/48` in request comes back as `fd00:aaaa:123::/48` in response
-func ipv6CompressionDiffSuppressFunction(key string, old string, new string, d *schema.ResourceData) bool {
+// canonicalCidrBlock returns the canonical form of a CIDR block, so that equivalent representations can be compared.
+// For example `fd00:aaaa:0123::/48` in request comes back as `fd00:aaaa:123::/48` in response.
+// Values that are not CIDR blocks, such as service CIDR labels and network security group OCIDs, are returned as they are.
+func canonicalCidrBlock(value string) string {
+	ip, ipNet, err := net.ParseCIDR(value)
+	if err != nil {
+		return value
+	}
+	prefixLength, _ := ipNet.Mask.Size()
+	return fmt.Sprintf("%s/%d", ip, prefixLength)
+}
+
+func cidrBlockDiffSuppressFunction(key string, old string, new string, d *schema.ResourceData) bool {
 	if old == "" || new == "" {
 		return false
 	}
-	oldIp := strings.Split(old, "/")
-	newIp := strings.Split(new, "/")
-	if len(oldIp) < 2 || len(newIp) < 2 {
+	return canonicalCidrBlock(old) == canonicalCidrBlock(new)
+}
+
+// canonicalCidrNetwork returns the canonical form of the network a CIDR block is in, so that a CIDR block with bits set
+// after the prefix, such as `10.0.0.1/24`, compares equal to the network `10.0.0.0/24` that the service stores for it.
+// Values that are not CIDR blocks are returned as they are.
+func canonicalCidrNetwork(value string) string {
+	_, ipNet, err := net.ParseCIDR(value)
+	if err != nil {
+		return value
+	}
+	return ipNet.String()
+}
+
+func cidrNetworkDiffSuppressFunction(key string, old string, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
 		return false
 	}
-	oldParsedIp := net.ParseIP(oldIp[0])
-	oldSubnetMask := oldIp[1]
-	newParsedIp := net.ParseIP(newIp[0])
-	newSubnetMask := oldIp[1]
-	return strings.EqualFold(oldParsedIp.String(), newParsedIp.String()) && strings.EqualFold(oldSubnetMask, newSubnetMask)
+	return canonicalCidrNetwork(old) == canonicalCidrNetwork(new)
+}
+
+// validateCidrBlock checks that a value is a CIDR block that has no bits set after the prefix, since the services
+// reject a CIDR block such as `10.0.0.1/24` instead of using the network it is in.
+func validateCidrBlock(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	ip, ipNet, err := net.ParseCIDR(v)
+	if err != nil {
+		es = append(es, fmt.Errorf("expected %s to be a CIDR block, got %s", k, v))
+		return
+	}
+	if !ip.Equal(ipNet.IP) {
+		es = append(es, fmt.Errorf("expected %s to be a CIDR block with no bits set after the prefix, got %s, did you mean %s?", k, v, ipNet))
+	}
+	return
+}
+
+// validateCidrBlockOrName validates values that look like CIDR blocks in arguments that also take names or OCIDs,
+// such as the destination of a route rule, which can be a CIDR block or a service CIDR label.
+func validateCidrBlockOrName(i interface{}, k string) (s []string, es []error) {
+	if v, ok := i.(string); ok && !strings.Contains(v, "/") {
+		return
+	}
+	return validateCidrBlock(i, k)
+}
+
+// validateCidrNetworkOrName validates values that look like CIDR blocks in the source and destination of security rules.
+// Unlike validateCidrBlockOrName it accepts bits set after the prefix, since the service stores the network the CIDR
+// block is in, and the difference is suppressed with canonicalCidrNetwork.
+func validateCidrNetworkOrName(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+	if !strings.Contains(v, "/") {
+		return
+	}
+	if _, _, err := net.ParseCIDR(v); err != nil {
+		es = append(es, fmt.Errorf("expected %s to be a CIDR block, got %s", k, v))
+	}
+	return
 }
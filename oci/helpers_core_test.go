@@ -6,11 +6,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"testing"
 	"time"
 
 	oci_common "github.com/oracle/oci-go-sdk/common"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 	oci_identity "github.com/oracle/oci-go-sdk/identity"
+	"github.com/stretchr/testify/assert"
 )
 
 const (
@@ -450,3 +452,87 @@ func bootVolumeBackupAvailableWaitCondition(response oci_common.OCIOperationResp
 
 	return false
 }
+
+func TestUnitCidrBlockDiffSuppressFunction(t *testing.T) {
+	tests := []struct {
+		old          string
+		new          string
+		suppressDiff bool
+	}{
+		{"10.0.0.0/16", "10.0.0.0/16", true},
+		{"10.0.0.0/16", "10.0.0.0/24", false},
+		{"10.0.0.0/16", "10.1.0.0/16", false},
+		{"fd00:aaaa:123::/48", "fd00:aaaa:0123::/48", true},
+		{"2001:db8::/56", "2001:DB8:0:0::/56", true},
+		{"2001:db8::/56", "2001:db8::/64", false},
+		{"oci-phx-objectstorage", "oci-phx-objectstorage", true},
+		{"oci-phx-objectstorage", "all-phx-services-in-oracle-services-network", false},
+		{"", "10.0.0.0/16", false},
+	}
+
+	for _, test := range tests {
+		if result := cidrBlockDiffSuppressFunction("cidr_block", test.old, test.new, nil); result != test.suppressDiff {
+			t.Errorf("expected diff suppression to be %t for '%s' and '%s', got %t", test.suppressDiff, test.old, test.new, result)
+		}
+	}
+}
+
+func TestUnitValidateCidrBlock(t *testing.T) {
+	_, errs := validateCidrBlock("10.0.0.0/24", "cidr_block")
+	assert.Empty(t, errs)
+	_, errs = validateCidrBlock("2001:db8::/56", "cidr_block")
+	assert.Empty(t, errs)
+	_, errs = validateCidrBlock("10.0.0.0", "cidr_block")
+	assert.Len(t, errs, 1)
+	_, errs = validateCidrBlock("10.0.0.0/33", "cidr_block")
+	assert.Len(t, errs, 1)
+
+	// A CIDR block with bits set after the prefix is rejected with the network it is in
+	_, errs = validateCidrBlock("10.0.0.1/24", "cidr_block")
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "expected cidr_block to be a CIDR block with no bits set after the prefix, got 10.0.0.1/24, did you mean 10.0.0.0/24?", errs[0].Error())
+
+	// Names and OCIDs are accepted where they can be used in place of a CIDR block
+	_, errs = validateCidrBlockOrName("oci-phx-objectstorage", "destination")
+	assert.Empty(t, errs)
+	_, errs = validateCidrBlockOrName("ocid1.networksecuritygroup.oc1.phx.aaaaaaaa", "source")
+	assert.Empty(t, errs)
+	_, errs = validateCidrBlockOrName("0.0.0.0/0", "source")
+	assert.Empty(t, errs)
+	_, errs = validateCidrBlockOrName("10.0.0.1/24", "destination")
+	assert.Len(t, errs, 1)
+}
+
+func TestUnitValidateCidrNetworkOrName(t *testing.T) {
+	_, errs := validateCidrNetworkOrName("0.0.0.0/0", "source")
+	assert.Empty(t, errs)
+	_, errs = validateCidrNetworkOrName("ocid1.networksecuritygroup.oc1.phx.aaaaaaaa", "source")
+	assert.Empty(t, errs)
+
+	// A CIDR block with bits set after the prefix is accepted in security rules, the service stores the network it is in
+	_, errs = validateCidrNetworkOrName("10.0.0.1/24", "destination")
+	assert.Empty(t, errs)
+	_, errs = validateCidrNetworkOrName("10.0.0.0/33", "destination")
+	assert.Len(t, errs, 1)
+}
+
+func TestUnitCidrNetworkDiffSuppressFunction(t *testing.T) {
+	tests := []struct {
+		old          string
+		new          string
+		suppressDiff bool
+	}{
+		{"10.0.0.0/24", "10.0.0.1/24", true},
+		{"10.0.0.0/24", "10.0.1.1/24", false},
+		{"10.0.0.0/24", "10.0.0.1/16", false},
+		{"fd00:aaaa:123::/48", "fd00:aaaa:0123::1/48", true},
+		{"oci-phx-objectstorage", "oci-phx-objectstorage", true},
+		{"", "10.0.0.0/24", false},
+	}
+
+	for _, test := range tests {
+		if result := cidrNetworkDiffSuppressFunction("source", test.old, test.new, nil); result != test.suppressDiff {
+			t.Errorf("expected diff suppression to be %t for '%s' and '%s', got %t", test.suppressDiff, test.old, test.new, result)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"strings"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+)
+
+const (
+	snapshotService = "snapshot"
+)
+
+var fileStorageServiceExpectedRetryDurationMap = map[string]serviceExpectedRetryDurationFunc{
+	snapshotService: getSnapshotExpectedRetryDuration,
+}
+
+func getFileStorageExpectedRetryDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, optionals ...interface{}) time.Duration {
+	if len(optionals) > 0 {
+		if key, ok := optionals[0].(string); ok {
+			if expectedRetryDurationFunc, ok := fileStorageServiceExpectedRetryDurationMap[key]; ok {
+				return expectedRetryDurationFunc(response, disableNotFoundRetries, optionals[1:]...)
+			}
+		}
+	}
+	return getDefaultExpectedRetryDuration(response, disableNotFoundRetries)
+}
+
+// A conflict on a snapshot is either a duplicate snapshot name on create, or a snapshot that is
+// still in use (e.g. as the source of a clone) on delete. Neither resolves itself by retrying, so
+// fail fast and surface the service's reason. Only a snapshot in a transitional state is retried.
+func getSnapshotExpectedRetryDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, optionals ...interface{}) time.Duration {
+	defaultRetryTime := getDefaultExpectedRetryDuration(response, disableNotFoundRetries)
+	if response.Response == nil || response.Response.HTTPResponse() == nil {
+		return defaultRetryTime
+	}
+	switch statusCode := response.Response.HTTPResponse().StatusCode; statusCode {
+	case 409:
+		if e := response.Error; e != nil && !strings.Contains(e.Error(), "IncorrectState") {
+			defaultRetryTime = 0
+		}
+	}
+	return defaultRetryTime
+}
@@ -1,10 +1,14 @@
 package oci
 
 import (
+	"context"
+	"fmt"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_kms "github.com/oracle/oci-go-sdk/keymanagement"
 
 	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
 )
@@ -42,3 +46,47 @@ func getKmsNextRetryDuration(response oci_common.OCIOperationResponse, disableNo
 	}
 	return defaultRetryTime
 }
+
+// getKmsManagementEndpoint returns the management endpoint of a KMS resource, which is looked up from the vault in
+// vault_id when management_endpoint is not set
+func getKmsManagementEndpoint(d *schema.ResourceData, m interface{}) (string, error) {
+	return getKmsVaultEndpoint(d, m, "management_endpoint", func(vault oci_kms.Vault) *string {
+		return vault.ManagementEndpoint
+	})
+}
+
+// getKmsCryptoEndpoint returns the crypto endpoint of a KMS resource, which is looked up from the vault in vault_id
+// when crypto_endpoint is not set
+func getKmsCryptoEndpoint(d *schema.ResourceData, m interface{}) (string, error) {
+	return getKmsVaultEndpoint(d, m, "crypto_endpoint", func(vault oci_kms.Vault) *string {
+		return vault.CryptoEndpoint
+	})
+}
+
+func getKmsVaultEndpoint(d *schema.ResourceData, m interface{}, endpointAttribute string, vaultEndpoint func(oci_kms.Vault) *string) (string, error) {
+	if endpoint, ok := d.GetOkExists(endpointAttribute); ok && endpoint.(string) != "" {
+		return endpoint.(string), nil
+	}
+
+	vaultId, ok := d.GetOkExists("vault_id")
+	if !ok || vaultId.(string) == "" {
+		return "", fmt.Errorf("one of %s or vault_id must be set", endpointAttribute)
+	}
+
+	request := oci_kms.GetVaultRequest{}
+	tmp := vaultId.(string)
+	request.VaultId = &tmp
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(true, "kms")
+
+	response, err := m.(*OracleClients).kmsVaultClient.GetVault(context.Background(), request)
+	if err != nil {
+		return "", fmt.Errorf("unable to look up the %s of vault %s: %v", endpointAttribute, tmp, err)
+	}
+	endpoint := vaultEndpoint(response.Vault)
+	if endpoint == nil || *endpoint == "" {
+		return "", fmt.Errorf("vault %s has no %s", tmp, endpointAttribute)
+	}
+
+	d.Set(endpointAttribute, *endpoint)
+	return *endpoint, nil
+}
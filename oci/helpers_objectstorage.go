@@ -449,6 +449,8 @@ func copyObjectWaitForWorkRequest(wId *string, entityType string, timeout time.D
 		Timeout: timeout,
 	}
 
+	setPollInterval(stateConf, providerPollInterval)
+
 	// Set PollInterval to 1 for replay mode.
 	if httpreplay.ShouldRetryImmediately() {
 		stateConf.PollInterval = 1
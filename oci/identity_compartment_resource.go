@@ -23,6 +23,8 @@ func IdentityCompartmentResource() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 		Timeouts: &schema.ResourceTimeout{
+			Create: &TwentyMinutes,
+			Update: &TwentyMinutes,
 			Delete: getTimeoutDuration("90m"), // service team states: p50: 30 min, p90: 60 min, max: 180 min
 		},
 		Create: createIdentityCompartment,
@@ -151,6 +153,10 @@ func (s *IdentityCompartmentResourceCrud) CreatedTarget() []string {
 	}
 }
 
+func (s *IdentityCompartmentResourceCrud) IsEventuallyConsistent() bool {
+	return true
+}
+
 func (s *IdentityCompartmentResourceCrud) DeletedPending() []string {
 	return []string{
 		string(oci_identity.CompartmentLifecycleStateDeleting),
@@ -172,6 +172,10 @@ func (s *IdentityPolicyResourceCrud) CreatedTarget() []string {
 	}
 }
 
+func (s *IdentityPolicyResourceCrud) IsEventuallyConsistent() bool {
+	return true
+}
+
 func (s *IdentityPolicyResourceCrud) DeletedPending() []string {
 	return []string{
 		string(oci_identity.PolicyLifecycleStateDeleting),
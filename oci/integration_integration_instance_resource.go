@@ -4,7 +4,7 @@ package oci
 
 import (
 	"context"
-	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -111,30 +111,7 @@ func createIntegrationIntegrationInstance(d *schema.ResourceData, m interface{})
 	sync.D = d
 	sync.Client = m.(*OracleClients).integrationInstanceClient
 
-	var powerOff = false
-	if configState, ok := sync.D.GetOkExists("state"); ok {
-		wantedState := oci_integration.IntegrationInstanceLifecycleStateEnum(strings.ToUpper(configState.(string)))
-		if wantedState == oci_integration.IntegrationInstanceLifecycleStateInactive {
-			powerOff = true
-		}
-	}
-
-	if error := CreateResource(d, sync); error != nil {
-		return error
-	}
-
-	if powerOff {
-		return powerOffIntegrationInstance(d, sync)
-	}
-
-	return nil
-}
-
-func powerOffIntegrationInstance(d *schema.ResourceData, sync *IntegrationIntegrationInstanceResourceCrud) error {
-	if err := sync.StopIntegerationInstance(); err != nil {
-		return err
-	}
-	return ReadResource(sync)
+	return CreateResource(d, sync)
 }
 
 func readIntegrationIntegrationInstance(d *schema.ResourceData, m interface{}) error {
@@ -150,43 +127,7 @@ func updateIntegrationIntegrationInstance(d *schema.ResourceData, m interface{})
 	sync.D = d
 	sync.Client = m.(*OracleClients).integrationInstanceClient
 
-	// Start/Stop Integration instance
-	powerOn, powerOff := false, false
-
-	if sync.D.HasChange("state") {
-		wantedState := strings.ToUpper(sync.D.Get("state").(string))
-		if oci_integration.IntegrationInstanceLifecycleStateActive == oci_integration.IntegrationInstanceLifecycleStateEnum(wantedState) {
-			powerOn = true
-		} else if oci_integration.IntegrationInstanceLifecycleStateInactive == oci_integration.IntegrationInstanceLifecycleStateEnum(wantedState) {
-			powerOff = true
-		} else {
-			return fmt.Errorf("[ERROR] Invalid state input for update %v", wantedState)
-		}
-	}
-
-	if powerOn {
-		if err := sync.StartIntegerationInstance(); err != nil {
-			return err
-		}
-		if err := sync.D.Set("state", oci_integration.IntegrationInstanceLifecycleStateActive); err != nil {
-			return err
-		}
-	}
-
-	if err := UpdateResource(d, sync); err != nil {
-		return err
-	}
-
-	if powerOff {
-		if err := sync.StopIntegerationInstance(); err != nil {
-			return err
-		}
-		if err := sync.D.Set("state", oci_integration.IntegrationInstanceLifecycleStateInactive); err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return UpdateResource(d, sync)
 }
 
 func deleteIntegrationIntegrationInstance(d *schema.ResourceData, m interface{}) error {
@@ -357,6 +298,7 @@ func integrationInstanceWaitForWorkRequest(wId *string, entityType string, actio
 		},
 		Timeout: timeout,
 	}
+	setPollInterval(stateConf, providerPollInterval)
 	if _, e := stateConf.WaitForState(); e != nil {
 		return nil, e
 	}
@@ -397,11 +339,24 @@ func getErrorFromIntegrationInstanceWorkRequest(client *oci_integration.Integrat
 	for _, wrkErr := range response.Items {
 		allErrs = append(allErrs, *wrkErr.Message)
 	}
-	errorMessage := strings.Join(allErrs, "\n")
 
-	workRequestErr := fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *workRequestId, entityType, action, errorMessage)
+	logs := make([]string, 0)
+	logsResponse, err := client.ListWorkRequestLogs(context.Background(),
+		oci_integration.ListWorkRequestLogsRequest{
+			CompartmentId: compartmentId,
+			WorkRequestId: workRequestId,
+			RequestMetadata: oci_common.RequestMetadata{
+				RetryPolicy: retryPolicy,
+			},
+		})
+	if err != nil {
+		log.Printf("[WARN] Could not list the log entries of work request %s: %v", *workRequestId, err)
+	}
+	for _, logEntry := range logsResponse.Items {
+		logs = append(logs, *logEntry.Message)
+	}
 
-	return workRequestErr
+	return newWorkRequestError(workRequestId, entityType, string(action), response.OpcRequestId, allErrs, logs)
 }
 
 func (s *IntegrationIntegrationInstanceResourceCrud) Get() error {
@@ -562,48 +517,42 @@ func (s *IntegrationIntegrationInstanceResourceCrud) updateCompartment(compartme
 	return nil
 }
 
-func (s *IntegrationIntegrationInstanceResourceCrud) StartIntegerationInstance() error {
-	state := oci_integration.IntegrationInstanceLifecycleStateActive
-	if err := s.Get(); err != nil {
-		return err
-	}
-	if s.Res.LifecycleState == state {
-		fmt.Printf("[WARN] The Integration instance already in the wanted state: %v", state)
-		return nil
-	}
+func (s *IntegrationIntegrationInstanceResourceCrud) RunningState() string {
+	return string(oci_integration.IntegrationInstanceLifecycleStateActive)
+}
+
+func (s *IntegrationIntegrationInstanceResourceCrud) StoppedState() string {
+	return string(oci_integration.IntegrationInstanceLifecycleStateInactive)
+}
+
+func (s *IntegrationIntegrationInstanceResourceCrud) Start() error {
 	request := oci_integration.StartIntegrationInstanceRequest{}
 
 	tmp := s.D.Id()
 	request.IntegrationInstanceId = &tmp
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "integration")
 
-	if _, err := s.Client.StartIntegrationInstance(context.Background(), request); err != nil {
+	response, err := s.Client.StartIntegrationInstance(context.Background(), request)
+	if err != nil {
 		return err
 	}
-	resourceChangedFunc := func() bool { return s.Res.LifecycleState == state }
 
-	return WaitForResourceCondition(s, resourceChangedFunc, s.D.Timeout(schema.TimeoutUpdate))
+	workId := response.OpcWorkRequestId
+	return s.getIntegrationInstanceFromWorkRequest(workId, getRetryPolicy(s.DisableNotFoundRetries, "integration"), oci_integration.WorkRequestResourceActionTypeStarted, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *IntegrationIntegrationInstanceResourceCrud) StopIntegerationInstance() error {
-	state := oci_integration.IntegrationInstanceLifecycleStateInactive
-	if err := s.Get(); err != nil {
-		return err
-	}
-	if s.Res.LifecycleState == state {
-		fmt.Printf("[WARN] The Integration instance already in the wanted state: %v", state)
-		return nil
-	}
+func (s *IntegrationIntegrationInstanceResourceCrud) Stop() error {
 	request := oci_integration.StopIntegrationInstanceRequest{}
 
 	tmp := s.D.Id()
 	request.IntegrationInstanceId = &tmp
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "integration")
 
-	if _, err := s.Client.StopIntegrationInstance(context.Background(), request); err != nil {
+	response, err := s.Client.StopIntegrationInstance(context.Background(), request)
+	if err != nil {
 		return err
 	}
-	resourceChangedFunc := func() bool { return s.Res.LifecycleState == state }
 
-	return WaitForResourceCondition(s, resourceChangedFunc, s.D.Timeout(schema.TimeoutUpdate))
+	workId := response.OpcWorkRequestId
+	return s.getIntegrationInstanceFromWorkRequest(workId, getRetryPolicy(s.DisableNotFoundRetries, "integration"), oci_integration.WorkRequestResourceActionTypeStopped, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -5,8 +5,6 @@ package oci
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/hashicorp/terraform/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/keymanagement"
 )
@@ -30,16 +28,27 @@ func KmsDecryptedDataDataSource() *schema.Resource {
 			},
 			"crypto_endpoint": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"key_id": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"logging_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     schema.TypeString,
+			},
+			"vault_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			// Computed
 			"plaintext": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 			"plaintext_checksum": {
 				Type:     schema.TypeString,
@@ -52,11 +61,11 @@ func KmsDecryptedDataDataSource() *schema.Resource {
 func readSingularDecryptedData(d *schema.ResourceData, m interface{}) error {
 	sync := &DecryptedDataDataSourceCrud{}
 	sync.D = d
-	endpoint, ok := d.GetOkExists("crypto_endpoint")
-	if !ok {
-		return fmt.Errorf("crypto_endpoint missing")
+	endpoint, err := getKmsCryptoEndpoint(d, m)
+	if err != nil {
+		return err
 	}
-	client, err := m.(*OracleClients).KmsCryptoClient(endpoint.(string))
+	client, err := m.(*OracleClients).KmsCryptoClient(endpoint)
 	if err != nil {
 		return err
 	}
@@ -92,6 +101,10 @@ func (s *DecryptedDataDataSourceCrud) Get() error {
 		request.KeyId = &tmp
 	}
 
+	if loggingContext, ok := s.D.GetOkExists("logging_context"); ok {
+		request.LoggingContext = objectMapToStringMap(loggingContext.(map[string]interface{}))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "kms")
 
 	response, err := s.Client.Decrypt(context.Background(), request)
@@ -5,8 +5,6 @@ package oci
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/hashicorp/terraform/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/keymanagement"
 )
@@ -26,15 +24,26 @@ func KmsEncryptedDataDataSource() *schema.Resource {
 			},
 			"crypto_endpoint": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"key_id": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"logging_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     schema.TypeString,
+			},
 			"plaintext": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"vault_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			// Computed
 			"ciphertext": {
@@ -48,11 +57,11 @@ func KmsEncryptedDataDataSource() *schema.Resource {
 func readSingularEncryptedData(d *schema.ResourceData, m interface{}) error {
 	sync := &EncryptedDataDataSourceCrud{}
 	sync.D = d
-	endpoint, ok := d.GetOkExists("crypto_endpoint")
-	if !ok {
-		return fmt.Errorf("crypto_endpoint missing")
+	endpoint, err := getKmsCryptoEndpoint(d, m)
+	if err != nil {
+		return err
 	}
-	client, err := m.(*OracleClients).KmsCryptoClient(endpoint.(string))
+	client, err := m.(*OracleClients).KmsCryptoClient(endpoint)
 	if err != nil {
 		return err
 	}
@@ -83,6 +92,10 @@ func (s *EncryptedDataDataSourceCrud) Get() error {
 		request.KeyId = &tmp
 	}
 
+	if loggingContext, ok := s.D.GetOkExists("logging_context"); ok {
+		request.LoggingContext = objectMapToStringMap(loggingContext.(map[string]interface{}))
+	}
+
 	if plaintext, ok := s.D.GetOkExists("plaintext"); ok {
 		tmp := plaintext.(string)
 		request.Plaintext = &tmp
@@ -7,8 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 
-	"fmt"
-
 	"github.com/hashicorp/terraform/helper/hashcode"
 	oci_kms "github.com/oracle/oci-go-sdk/keymanagement"
 )
@@ -25,20 +23,16 @@ func KmsEncryptedDataResource() *schema.Resource {
 		Delete:   deleteKmsEncryptedData,
 		Schema: map[string]*schema.Schema{
 			// Required
-			"crypto_endpoint": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
 			"key_id": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
 			"plaintext": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
 			},
 
 			// Optional
@@ -48,12 +42,23 @@ func KmsEncryptedDataResource() *schema.Resource {
 				ForceNew: true,
 				Elem:     schema.TypeString,
 			},
+			"crypto_endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 			"logging_context": {
 				Type:     schema.TypeMap,
 				Optional: true,
 				ForceNew: true,
 				Elem:     schema.TypeString,
 			},
+			"vault_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 
 			// Computed
 			"ciphertext": {
@@ -67,11 +72,11 @@ func KmsEncryptedDataResource() *schema.Resource {
 func createKmsEncryptedData(d *schema.ResourceData, m interface{}) error {
 	sync := &KmsEncryptedDataResourceCrud{}
 	sync.D = d
-	endpoint, ok := d.GetOkExists("crypto_endpoint")
-	if !ok {
-		return fmt.Errorf("crypto_endpoint missing")
+	endpoint, err := getKmsCryptoEndpoint(d, m)
+	if err != nil {
+		return err
 	}
-	client, err := m.(*OracleClients).KmsCryptoClient(endpoint.(string))
+	client, err := m.(*OracleClients).KmsCryptoClient(endpoint)
 	if err != nil {
 		return err
 	}
@@ -83,11 +88,11 @@ func createKmsEncryptedData(d *schema.ResourceData, m interface{}) error {
 func readKmsEncryptedData(d *schema.ResourceData, m interface{}) error {
 	sync := &KmsEncryptedDataResourceCrud{}
 	sync.D = d
-	endpoint, ok := d.GetOkExists("crypto_endpoint")
-	if !ok {
-		return fmt.Errorf("crypto_endpoint missing")
+	endpoint, err := getKmsCryptoEndpoint(d, m)
+	if err != nil {
+		return err
 	}
-	client, err := m.(*OracleClients).KmsCryptoClient(endpoint.(string))
+	client, err := m.(*OracleClients).KmsCryptoClient(endpoint)
 	if err != nil {
 		return err
 	}
@@ -24,6 +24,7 @@ var (
 		"key_id":          Representation{repType: Required, create: `${lookup(data.oci_kms_keys.test_keys_dependency.keys[0], "id")}`},
 		"plaintext":       Representation{repType: Required, create: `aGVsbG8sIHdvcmxk`},
 		"associated_data": Representation{repType: Optional, create: map[string]string{"associatedData": "associatedData"}, update: map[string]string{"associatedData2": "associatedData2"}},
+		"logging_context": Representation{repType: Optional, create: map[string]string{"loggingContext": "loggingContext"}, update: map[string]string{"loggingContext2": "loggingContext2"}},
 	}
 
 	encryptedDataRepresentation = map[string]interface{}{
@@ -95,6 +96,7 @@ func TestKmsEncryptedDataResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(singularDatasourceName, "associated_data.%", "1"),
 					resource.TestCheckResourceAttrSet(singularDatasourceName, "crypto_endpoint"),
 					resource.TestCheckResourceAttrSet(singularDatasourceName, "key_id"),
+					resource.TestCheckResourceAttr(singularDatasourceName, "logging_context.%", "1"),
 					resource.TestCheckResourceAttr(singularDatasourceName, "plaintext", "aGVsbG8sIHdvcmxk"),
 
 					resource.TestCheckResourceAttrSet(singularDatasourceName, "ciphertext"),
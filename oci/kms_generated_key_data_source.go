@@ -0,0 +1,188 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_kms "github.com/oracle/oci-go-sdk/keymanagement"
+)
+
+func init() {
+	RegisterDatasource("oci_kms_generated_key", KmsGeneratedKeyDataSource())
+}
+
+func KmsGeneratedKeyDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readSingularGeneratedKey,
+		Schema: map[string]*schema.Schema{
+			"associated_data": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     schema.TypeString,
+			},
+			"crypto_endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"include_plaintext_key": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"key_shape": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"algorithm": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"length": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"logging_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     schema.TypeString,
+			},
+			"vault_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed
+			"ciphertext": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"plaintext": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"plaintext_checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func readSingularGeneratedKey(d *schema.ResourceData, m interface{}) error {
+	sync := &GeneratedKeyDataSourceCrud{}
+	sync.D = d
+	endpoint, err := getKmsCryptoEndpoint(d, m)
+	if err != nil {
+		return err
+	}
+	client, err := m.(*OracleClients).KmsCryptoClient(endpoint)
+	if err != nil {
+		return err
+	}
+	sync.Client = client
+
+	return ReadResource(sync)
+}
+
+type GeneratedKeyDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_kms.KmsCryptoClient
+	Res    *oci_kms.GenerateDataEncryptionKeyResponse
+}
+
+func (s *GeneratedKeyDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *GeneratedKeyDataSourceCrud) Get() error {
+	request := oci_kms.GenerateDataEncryptionKeyRequest{}
+
+	if associatedData, ok := s.D.GetOkExists("associated_data"); ok {
+		request.AssociatedData = objectMapToStringMap(associatedData.(map[string]interface{}))
+	}
+
+	if includePlaintextKey, ok := s.D.GetOkExists("include_plaintext_key"); ok {
+		tmp := includePlaintextKey.(bool)
+		request.IncludePlaintextKey = &tmp
+	}
+
+	if keyId, ok := s.D.GetOkExists("key_id"); ok {
+		tmp := keyId.(string)
+		request.KeyId = &tmp
+	}
+
+	if keyShape, ok := s.D.GetOkExists("key_shape"); ok {
+		if tmpList := keyShape.([]interface{}); len(tmpList) > 0 {
+			fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "key_shape", 0)
+			tmp, err := s.mapToKeyShape(fieldKeyFormat)
+			if err != nil {
+				return err
+			}
+			request.KeyShape = &tmp
+		}
+	}
+
+	if loggingContext, ok := s.D.GetOkExists("logging_context"); ok {
+		request.LoggingContext = objectMapToStringMap(loggingContext.(map[string]interface{}))
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "kms")
+
+	response, err := s.Client.GenerateDataEncryptionKey(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	return nil
+}
+
+func (s *GeneratedKeyDataSourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.SetId(GenerateDataSourceID())
+
+	if s.Res.Ciphertext != nil {
+		s.D.Set("ciphertext", *s.Res.Ciphertext)
+	}
+
+	if s.Res.Plaintext != nil {
+		s.D.Set("plaintext", *s.Res.Plaintext)
+	}
+
+	if s.Res.PlaintextChecksum != nil {
+		s.D.Set("plaintext_checksum", *s.Res.PlaintextChecksum)
+	}
+
+	return nil
+}
+
+func (s *GeneratedKeyDataSourceCrud) mapToKeyShape(fieldKeyFormat string) (oci_kms.KeyShape, error) {
+	result := oci_kms.KeyShape{}
+
+	if algorithm, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "algorithm")); ok {
+		result.Algorithm = oci_kms.KeyShapeAlgorithmEnum(algorithm.(string))
+	}
+
+	if length, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "length")); ok {
+		tmp := length.(int)
+		result.Length = &tmp
+	}
+
+	return result, nil
+}
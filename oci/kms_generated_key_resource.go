@@ -24,11 +24,6 @@ func KmsGeneratedKeyResource() *schema.Resource {
 		Delete:   deleteKmsGeneratedKey,
 		Schema: map[string]*schema.Schema{
 			// Required
-			"crypto_endpoint": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
 			"include_plaintext_key": {
 				Type:     schema.TypeBool,
 				Required: true,
@@ -73,12 +68,23 @@ func KmsGeneratedKeyResource() *schema.Resource {
 				ForceNew: true,
 				Elem:     schema.TypeString,
 			},
+			"crypto_endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 			"logging_context": {
 				Type:     schema.TypeMap,
 				Optional: true,
 				ForceNew: true,
 				Elem:     schema.TypeString,
 			},
+			"vault_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 
 			// Computed
 			"ciphertext": {
@@ -86,8 +92,9 @@ func KmsGeneratedKeyResource() *schema.Resource {
 				Computed: true,
 			},
 			"plaintext": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 			"plaintext_checksum": {
 				Type:     schema.TypeString,
@@ -100,11 +107,11 @@ func KmsGeneratedKeyResource() *schema.Resource {
 func createKmsGeneratedKey(d *schema.ResourceData, m interface{}) error {
 	sync := &KmsGeneratedKeyResourceCrud{}
 	sync.D = d
-	endpoint, ok := d.GetOkExists("crypto_endpoint")
-	if !ok {
-		return fmt.Errorf("crypto_endpoint missing")
+	endpoint, err := getKmsCryptoEndpoint(d, m)
+	if err != nil {
+		return err
 	}
-	client, err := m.(*OracleClients).KmsCryptoClient(endpoint.(string))
+	client, err := m.(*OracleClients).KmsCryptoClient(endpoint)
 	if err != nil {
 		return err
 	}
@@ -24,6 +24,14 @@ var (
 		"associated_data":       Representation{repType: Optional, create: map[string]string{"associatedData": "associatedData"}, update: map[string]string{"associatedData2": "associatedData2"}},
 		"logging_context":       Representation{repType: Optional, create: map[string]string{"loggingContext": "loggingContext"}, update: map[string]string{"loggingContext2": "loggingContext2"}},
 	}
+	generatedKeySingularDataSourceRepresentation = map[string]interface{}{
+		"include_plaintext_key": Representation{repType: Required, create: `true`},
+		"key_id":                Representation{repType: Required, create: `${lookup(data.oci_kms_keys.test_keys_dependency.keys[0], "id")}`},
+		"key_shape":             RepresentationGroup{Required, generatedKeyKeyShapeRepresentation},
+		"vault_id":              Representation{repType: Required, create: `${data.oci_kms_vault.test_vault.id}`},
+		"associated_data":       Representation{repType: Optional, create: map[string]string{"associatedData": "associatedData"}},
+		"logging_context":       Representation{repType: Optional, create: map[string]string{"loggingContext": "loggingContext"}},
+	}
 	generatedKeyKeyShapeRepresentation = map[string]interface{}{
 		"algorithm": Representation{repType: Required, create: `AES`},
 		"length":    Representation{repType: Required, create: `16`},
@@ -43,6 +51,7 @@ func TestKmsGeneratedKeyResource_basic(t *testing.T) {
 	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
 
 	resourceName := "oci_kms_generated_key.test_generated_key"
+	singularDatasourceName := "data.oci_kms_generated_key.test_generated_key"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() { testAccPreCheck(t) },
@@ -84,6 +93,28 @@ func TestKmsGeneratedKeyResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "logging_context.%", "1"),
 				),
 			},
+
+			// verify singular datasource
+			{
+				Config: config +
+					generateDataSourceFromRepresentationMap("oci_kms_generated_key", "test_generated_key", Optional, Create, generatedKeySingularDataSourceRepresentation) +
+					compartmentIdVariableStr + GeneratedKeyResourceDependencies,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(singularDatasourceName, "associated_data.%", "1"),
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "crypto_endpoint"),
+					resource.TestCheckResourceAttr(singularDatasourceName, "include_plaintext_key", "true"),
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "key_id"),
+					resource.TestCheckResourceAttr(singularDatasourceName, "key_shape.#", "1"),
+					resource.TestCheckResourceAttr(singularDatasourceName, "key_shape.0.algorithm", "AES"),
+					resource.TestCheckResourceAttr(singularDatasourceName, "key_shape.0.length", "16"),
+					resource.TestCheckResourceAttr(singularDatasourceName, "logging_context.%", "1"),
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "vault_id"),
+
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "ciphertext"),
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "plaintext"),
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "plaintext_checksum"),
+				),
+			},
 		},
 	})
 }
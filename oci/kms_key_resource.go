@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"regexp"
@@ -55,6 +56,10 @@ func KmsKeyResource() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(oci_kms.KeyShapeAlgorithmAes),
+								string(oci_kms.KeyShapeAlgorithmRsa),
+							}, false),
 						},
 						"length": {
 							Type:     schema.TypeInt,
@@ -68,11 +73,6 @@ func KmsKeyResource() *schema.Resource {
 					},
 				},
 			},
-			"management_endpoint": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
 
 			// Optional
 			"defined_tags": {
@@ -88,6 +88,28 @@ func KmsKeyResource() *schema.Resource {
 				Computed: true,
 				Elem:     schema.TypeString,
 			},
+			"key_source": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_kms.KeyVersionOriginInternal),
+					string(oci_kms.KeyVersionOriginExternal),
+				}, false),
+			},
+			"management_endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vault_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 			"desired_state": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -97,11 +119,42 @@ func KmsKeyResource() *schema.Resource {
 					"DISABLED",
 				}, false),
 			},
+			"rotation_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"time_of_deletion": {
 				Type:     schema.TypeString,
 				Computed: true,
 				Optional: true,
 			},
+			"wrapped_import_key": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"key_material": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"wrapping_algorithm": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(oci_kms.WrappedImportKeyWrappingAlgorithmRsaOaepSha256),
+							}, false),
+						},
+
+						// Optional
+
+						// Computed
+					},
+				},
+			},
 
 			// Computed
 			"current_key_version": {
@@ -116,22 +169,65 @@ func KmsKeyResource() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"vault_id": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
 		},
+		CustomizeDiff: customdiff.All(
+			validateKmsKeyShape,
+			restoreKmsKeyPendingDeletion,
+		),
 	}
 }
 
+// The key lengths, in bytes, that are supported for each key shape algorithm
+var kmsKeyShapeLengths = map[string][]int{
+	string(oci_kms.KeyShapeAlgorithmAes): {16, 24, 32},
+	string(oci_kms.KeyShapeAlgorithmRsa): {256, 384, 512},
+}
+
+func validateKmsKeyShape(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("key_shape.0.algorithm") || !d.NewValueKnown("key_shape.0.length") {
+		return nil
+	}
+
+	algorithm := d.Get("key_shape.0.algorithm").(string)
+	length := d.Get("key_shape.0.length").(int)
+	lengths, ok := kmsKeyShapeLengths[algorithm]
+	if !ok {
+		return nil
+	}
+	for _, supportedLength := range lengths {
+		if length == supportedLength {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid key_shape length %d for algorithm %s: expected one of %v", length, algorithm, lengths)
+}
+
+// Keys that are pending deletion are kept in the state, and restored by cancelling their deletion when they are still
+// declared in the configuration
+func restoreKmsKeyPendingDeletion(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !isKmsKeyPendingDeletion(d.Get("state").(string)) {
+		return nil
+	}
+
+	if desiredState := d.Get("desired_state").(string); desiredState != string(oci_kms.KeyLifecycleStateEnabled) && desiredState != string(oci_kms.KeyLifecycleStateDisabled) {
+		return d.SetNew("desired_state", string(oci_kms.KeyLifecycleStateEnabled))
+	}
+	return nil
+}
+
+func isKmsKeyPendingDeletion(state string) bool {
+	return state == string(oci_kms.KeyLifecycleStatePendingDeletion) || state == string(oci_kms.KeyLifecycleStateSchedulingDeletion)
+}
+
 func createKmsKey(d *schema.ResourceData, m interface{}) error {
 	sync := &KmsKeyResourceCrud{}
 	sync.D = d
-	endpoint, ok := d.GetOkExists("management_endpoint")
-	if !ok {
-		return fmt.Errorf("management endpoint missing")
+	endpoint, err := getKmsManagementEndpoint(d, m)
+	if err != nil {
+		return err
 	}
-	client, err := m.(*OracleClients).KmsManagementClient(endpoint.(string))
+	client, err := m.(*OracleClients).KmsManagementClient(endpoint)
 	if err != nil {
 		return err
 	}
@@ -143,22 +239,27 @@ func createKmsKey(d *schema.ResourceData, m interface{}) error {
 func readKmsKey(d *schema.ResourceData, m interface{}) error {
 	sync := &KmsKeyResourceCrud{}
 	sync.D = d
-	endpoint, ok := d.GetOkExists("management_endpoint")
-	if !ok {
+	if _, ok := d.GetOkExists("management_endpoint"); !ok {
 		//Import use case:
 		id := d.Id()
 		regex, _ := regexp.Compile("^managementEndpoint/(.*)/keys/(.*)$")
-		tokens := regex.FindStringSubmatch(id)
-		if len(tokens) == 3 {
-			endpoint = tokens[1]
-			d.Set("management_endpoint", endpoint)
+		vaultRegex, _ := regexp.Compile("^vaults/(.*)/keys/(.*)$")
+		if tokens := regex.FindStringSubmatch(id); len(tokens) == 3 {
+			d.Set("management_endpoint", tokens[1])
+			d.SetId(tokens[2])
+		} else if tokens := vaultRegex.FindStringSubmatch(id); len(tokens) == 3 {
+			d.Set("vault_id", tokens[1])
 			d.SetId(tokens[2])
 		} else {
-			return fmt.Errorf("id %s should be format: managementEndpoint/{managementEndpoint}/keys/{keyId}", id)
+			return fmt.Errorf("id %s should be format: vaults/{vaultId}/keys/{keyId} or managementEndpoint/{managementEndpoint}/keys/{keyId}", id)
 		}
 	}
 
-	client, err := m.(*OracleClients).KmsManagementClient(endpoint.(string))
+	endpoint, err := getKmsManagementEndpoint(d, m)
+	if err != nil {
+		return err
+	}
+	client, err := m.(*OracleClients).KmsManagementClient(endpoint)
 	if err != nil {
 		return err
 	}
@@ -170,11 +271,11 @@ func readKmsKey(d *schema.ResourceData, m interface{}) error {
 func updateKmsKey(d *schema.ResourceData, m interface{}) error {
 	sync := &KmsKeyResourceCrud{}
 	sync.D = d
-	endpoint, ok := d.GetOkExists("management_endpoint")
-	if !ok {
-		return fmt.Errorf("management endpoint missing")
+	endpoint, err := getKmsManagementEndpoint(d, m)
+	if err != nil {
+		return err
 	}
-	client, err := m.(*OracleClients).KmsManagementClient(endpoint.(string))
+	client, err := m.(*OracleClients).KmsManagementClient(endpoint)
 	if err != nil {
 		return err
 	}
@@ -186,11 +287,11 @@ func updateKmsKey(d *schema.ResourceData, m interface{}) error {
 func deleteKmsKey(d *schema.ResourceData, m interface{}) error {
 	sync := &KmsKeyResourceCrud{}
 	sync.D = d
-	endpoint, ok := d.GetOkExists("management_endpoint")
-	if !ok {
-		return fmt.Errorf("management endpoint missing")
+	endpoint, err := getKmsManagementEndpoint(d, m)
+	if err != nil {
+		return err
 	}
-	client, err := m.(*OracleClients).KmsManagementClient(endpoint.(string))
+	client, err := m.(*OracleClients).KmsManagementClient(endpoint)
 	if err != nil {
 		return err
 	}
@@ -210,6 +311,14 @@ func (s *KmsKeyResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
+// A key that is pending deletion is not removed from the state, so that its deletion can be cancelled by an update
+func (s *KmsKeyResourceCrud) VoidState() {
+	if s.Res != nil && isKmsKeyPendingDeletion(string(s.Res.LifecycleState)) {
+		return
+	}
+	s.D.SetId("")
+}
+
 func (s *KmsKeyResourceCrud) CreatedPending() []string {
 	return []string{
 		string(oci_kms.KeyLifecycleStateCreating),
@@ -223,6 +332,10 @@ func (s *KmsKeyResourceCrud) CreatedTarget() []string {
 	}
 }
 
+func (s *KmsKeyResourceCrud) IsEventuallyConsistent() bool {
+	return true
+}
+
 func (s *KmsKeyResourceCrud) DeletedPending() []string {
 	return []string{
 		string(oci_kms.KeyLifecycleStateDisabled),
@@ -243,6 +356,7 @@ func (s *KmsKeyResourceCrud) UpdatedPending() []string {
 		string(oci_kms.KeyLifecycleStateEnabling),
 		string(oci_kms.KeyLifecycleStateDisabling),
 		string(oci_kms.KeyLifecycleStateUpdating),
+		string(oci_kms.KeyLifecycleStateCancellingDeletion),
 	}
 }
 
@@ -258,6 +372,13 @@ func (s *KmsKeyResourceCrud) Create() error {
 		return fmt.Errorf("oci_kms_keys can only be created in ENABLED state")
 	}
 
+	if s.isExternalKey() {
+		return s.importKey()
+	}
+	if _, ok := s.D.GetOkExists("wrapped_import_key"); ok {
+		return fmt.Errorf("wrapped_import_key can only be set when key_source is %s", oci_kms.KeyVersionOriginExternal)
+	}
+
 	request := oci_kms.CreateKeyRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -300,6 +421,62 @@ func (s *KmsKeyResourceCrud) Create() error {
 		return err
 	}
 
+	s.Res = &response.Key
+	s.D.Set("key_source", string(oci_kms.KeyVersionOriginInternal))
+	return nil
+}
+
+// importKey creates the key from the key material in wrapped_import_key, which was wrapped with the public key of
+// the wrapping key of the vault
+func (s *KmsKeyResourceCrud) importKey() error {
+	request := oci_kms.ImportKeyRequest{}
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
+		convertedDefinedTags, err := mapToDefinedTags(definedTags.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		request.DefinedTags = convertedDefinedTags
+	}
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := displayName.(string)
+		request.DisplayName = &tmp
+	}
+
+	if freeformTags, ok := s.D.GetOkExists("freeform_tags"); ok {
+		request.FreeformTags = objectMapToStringMap(freeformTags.(map[string]interface{}))
+	}
+
+	if keyShape, ok := s.D.GetOkExists("key_shape"); ok {
+		if tmpList := keyShape.([]interface{}); len(tmpList) > 0 {
+			fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "key_shape", 0)
+			tmp, err := s.mapToKeyShape(fieldKeyFormat)
+			if err != nil {
+				return err
+			}
+			request.KeyShape = &tmp
+		}
+	}
+
+	wrappedImportKey, err := s.wrappedImportKey()
+	if err != nil {
+		return err
+	}
+	request.WrappedImportKey = wrappedImportKey
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
+
+	response, err := s.Client.ImportKey(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
 	s.Res = &response.Key
 	return nil
 }
@@ -321,16 +498,45 @@ func (s *KmsKeyResourceCrud) Get() error {
 	return nil
 }
 
+// setKeySource reads the origin of the current key version, since the key itself does not record whether its key
+// material was generated by the vault or imported. It is only read when key_source is not known yet, such as after an
+// import, and not every time the key is polled.
+func (s *KmsKeyResourceCrud) setKeySource() error {
+	if keySource, ok := s.D.GetOkExists("key_source"); (ok && keySource.(string) != "") || s.Res.CurrentKeyVersion == nil {
+		return nil
+	}
+
+	request := oci_kms.GetKeyVersionRequest{}
+
+	tmp := s.D.Id()
+	request.KeyId = &tmp
+
+	request.KeyVersionId = s.Res.CurrentKeyVersion
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
+
+	response, err := s.Client.GetKeyVersion(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.D.Set("key_source", response.Origin)
+	return nil
+}
+
 func (s *KmsKeyResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
+	// Key versions of keys with generated key material can not be imported, rotating them would silently generate new
+	// key material instead
+	if s.D.HasChange("wrapped_import_key") && !s.isExternalKey() {
+		return fmt.Errorf("wrapped_import_key can only be changed when key_source is %s", oci_kms.KeyVersionOriginExternal)
+	}
+
+	if isKmsKeyPendingDeletion(s.D.Get("state").(string)) {
+		if err := s.cancelKeyDeletion(); err != nil {
+			return err
 		}
 	}
+
 	request := oci_kms.UpdateKeyRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -362,6 +568,14 @@ func (s *KmsKeyResourceCrud) Update() error {
 
 	s.Res = &response.Key
 
+	_, rotationTriggerOk := s.D.GetOkExists("rotation_trigger")
+	_, wrappedImportKeyOk := s.D.GetOkExists("wrapped_import_key")
+	if (rotationTriggerOk && s.D.HasChange("rotation_trigger")) || (wrappedImportKeyOk && s.D.HasChange("wrapped_import_key")) {
+		if err := s.rotateKey(); err != nil {
+			return err
+		}
+	}
+
 	// Handle activation/deactivation here
 	if desiredState, ok := s.D.GetOkExists("desired_state"); ok && !strings.EqualFold(desiredState.(string), s.D.Get("state").(string)) {
 		desiredStateString := desiredState.(string)
@@ -394,7 +608,38 @@ func (s *KmsKeyResourceCrud) Update() error {
 	return nil
 }
 
+// cancelKeyDeletion restores a key that is pending deletion and waits for it to leave the CANCELLING_DELETION state
+func (s *KmsKeyResourceCrud) cancelKeyDeletion() error {
+	request := oci_kms.CancelKeyDeletionRequest{}
+
+	tmp := s.D.Id()
+	request.KeyId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
+
+	_, err := s.Client.CancelKeyDeletion(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	keyRestoredFunc := func() bool {
+		return s.Res.LifecycleState == oci_kms.KeyLifecycleStateEnabled || s.Res.LifecycleState == oci_kms.KeyLifecycleStateDisabled
+	}
+	if err := WaitForResourceCondition(s, keyRestoredFunc, s.D.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+	s.D.Set("time_of_deletion", "")
+	return nil
+}
+
 func (s *KmsKeyResourceCrud) Delete() error {
+	// The deletion of a key that is pending deletion is already scheduled
+	if isKmsKeyPendingDeletion(s.D.Get("state").(string)) {
+		return nil
+	}
+
 	request := oci_kms.ScheduleKeyDeletionRequest{}
 
 	if timeOfDeletion, ok := s.D.GetOkExists("time_of_deletion"); ok {
@@ -413,6 +658,54 @@ func (s *KmsKeyResourceCrud) Delete() error {
 	return err
 }
 
+// rotateKey creates a new key version and waits for the key to use it as its current key version. The key versions of
+// external keys are imported from the key material in wrapped_import_key.
+func (s *KmsKeyResourceCrud) rotateKey() error {
+	var keyVersionId *string
+	if s.isExternalKey() {
+		request := oci_kms.ImportKeyVersionRequest{}
+
+		tmp := s.D.Id()
+		request.KeyId = &tmp
+
+		wrappedImportKey, err := s.wrappedImportKey()
+		if err != nil {
+			return err
+		}
+		request.WrappedImportKey = wrappedImportKey
+
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
+
+		response, err := s.Client.ImportKeyVersion(context.Background(), request)
+		if err != nil {
+			return err
+		}
+		keyVersionId = response.KeyVersion.Id
+	} else {
+		request := oci_kms.CreateKeyVersionRequest{}
+
+		tmp := s.D.Id()
+		request.KeyId = &tmp
+
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
+
+		response, err := s.Client.CreateKeyVersion(context.Background(), request)
+		if err != nil {
+			return err
+		}
+		keyVersionId = response.KeyVersion.Id
+	}
+
+	currentKeyVersionFunc := func() bool {
+		return s.Res.CurrentKeyVersion != nil && keyVersionId != nil && *s.Res.CurrentKeyVersion == *keyVersionId
+	}
+	return WaitForResourceCondition(s, currentKeyVersionFunc, s.D.Timeout(schema.TimeoutUpdate))
+}
+
+func (s *KmsKeyResourceCrud) IsDeletionProtected() bool {
+	return true
+}
+
 func (s *KmsKeyResourceCrud) SetData() error {
 	if s.Res.CompartmentId != nil {
 		s.D.Set("compartment_id", *s.Res.CompartmentId)
@@ -434,6 +727,10 @@ func (s *KmsKeyResourceCrud) SetData() error {
 
 	s.D.Set("desired_state", s.Res.LifecycleState)
 
+	if err := s.setKeySource(); err != nil {
+		return err
+	}
+
 	if s.Res.KeyShape != nil {
 		s.D.Set("key_shape", []interface{}{KeyShapeToMap(s.Res.KeyShape)})
 	} else {
@@ -472,6 +769,40 @@ func (s *KmsKeyResourceCrud) mapToKeyShape(fieldKeyFormat string) (oci_kms.KeySh
 	return result, nil
 }
 
+func (s *KmsKeyResourceCrud) isExternalKey() bool {
+	keySource, ok := s.D.GetOkExists("key_source")
+	return ok && keySource.(string) == string(oci_kms.KeyVersionOriginExternal)
+}
+
+func (s *KmsKeyResourceCrud) wrappedImportKey() (*oci_kms.WrappedImportKey, error) {
+	if wrappedImportKey, ok := s.D.GetOkExists("wrapped_import_key"); ok {
+		if tmpList := wrappedImportKey.([]interface{}); len(tmpList) > 0 {
+			fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "wrapped_import_key", 0)
+			tmp, err := s.mapToWrappedImportKey(fieldKeyFormat)
+			if err != nil {
+				return nil, err
+			}
+			return &tmp, nil
+		}
+	}
+	return nil, fmt.Errorf("wrapped_import_key must be set when key_source is %s", oci_kms.KeyVersionOriginExternal)
+}
+
+func (s *KmsKeyResourceCrud) mapToWrappedImportKey(fieldKeyFormat string) (oci_kms.WrappedImportKey, error) {
+	result := oci_kms.WrappedImportKey{}
+
+	if keyMaterial, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "key_material")); ok {
+		tmp := keyMaterial.(string)
+		result.KeyMaterial = &tmp
+	}
+
+	if wrappingAlgorithm, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "wrapping_algorithm")); ok {
+		result.WrappingAlgorithm = oci_kms.WrappedImportKeyWrappingAlgorithmEnum(wrappingAlgorithm.(string))
+	}
+
+	return result, nil
+}
+
 func KeyShapeToMap(obj *oci_kms.KeyShape) map[string]interface{} {
 	result := map[string]interface{}{}
 
@@ -484,7 +815,7 @@ func KeyShapeToMap(obj *oci_kms.KeyShape) map[string]interface{} {
 	return result
 }
 
-func (s *KmsKeyResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *KmsKeyResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_kms.ChangeKeyCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -499,5 +830,6 @@ func (s *KmsKeyResourceCrud) updateCompartment(compartment interface{}) error {
 	if err != nil {
 		return err
 	}
-	return nil
+
+	return waitForUpdatedState(s.D, s)
 }
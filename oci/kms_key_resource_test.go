@@ -157,6 +157,7 @@ func TestResourceKmsKeyResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "key_shape.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "key_shape.0.algorithm", "AES"),
 					resource.TestCheckResourceAttr(resourceName, "key_shape.0.length", "16"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_trigger", "rotation2"),
 					resource.TestCheckResourceAttrSet(resourceName, "state"),
 					resource.TestCheckResourceAttrSet(resourceName, "time_created"),
 					resource.TestCheckResourceAttrSet(resourceName, "vault_id"),
@@ -242,6 +243,22 @@ func TestResourceKmsKeyResource_basic(t *testing.T) {
 				ImportStateIdFunc: keyImportId,
 				ImportStateVerifyIgnore: []string{
 					"desired_state",
+					"key_source",
+					"rotation_trigger",
+					"time_of_deletion",
+				},
+				ResourceName: resourceName,
+			},
+			// verify resource import with the vault of the key
+			{
+				Config:            config,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: keyVaultImportId,
+				ImportStateVerifyIgnore: []string{
+					"desired_state",
+					"key_source",
+					"rotation_trigger",
 					"time_of_deletion",
 				},
 				ResourceName: resourceName,
@@ -9,9 +9,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/oracle/oci-go-sdk/common"
+	"github.com/stretchr/testify/assert"
 
 	oci_kms "github.com/oracle/oci-go-sdk/keymanagement"
 
@@ -49,6 +51,7 @@ var (
 		"desired_state":       Representation{repType: Optional, create: `ENABLED`, update: `DISABLED`},
 		"defined_tags":        Representation{repType: Optional, create: `${map("${oci_identity_tag_namespace.tag-namespace1.name}.${oci_identity_tag.tag1.name}", "value")}`, update: `${map("${oci_identity_tag_namespace.tag-namespace1.name}.${oci_identity_tag.tag1.name}", "updatedValue")}`},
 		"freeform_tags":       Representation{repType: Optional, create: map[string]string{"Department": "Finance"}, update: map[string]string{"Department": "Accounting"}},
+		"rotation_trigger":    Representation{repType: Optional, create: `rotation1`, update: `rotation2`},
 		"time_of_deletion":    Representation{repType: Optional, create: deletionTime.Format(time.RFC3339Nano)},
 	}
 	keyKeyShapeRepresentation = map[string]interface{}{
@@ -117,6 +120,7 @@ func TestKmsKeyResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "display_name", "Key C"),
 					resource.TestCheckResourceAttr(resourceName, "key_shape.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "key_shape.0.algorithm", "AES"),
+					resource.TestCheckResourceAttr(resourceName, "key_source", "INTERNAL"),
 					resource.TestCheckResourceAttr(resourceName, "key_shape.0.length", "16"),
 
 					func(s *terraform.State) (err error) {
@@ -289,6 +293,7 @@ func TestKmsKeyResource_basic(t *testing.T) {
 				ImportStateIdFunc: keyImportId,
 				ImportStateVerifyIgnore: []string{
 					"desired_state",
+					"rotation_trigger",
 					"time_of_deletion",
 				},
 				ResourceName: resourceName,
@@ -355,3 +360,55 @@ func keyImportId(state *terraform.State) (string, error) {
 
 	return "", fmt.Errorf("unable to create import id as no resource of type oci_kms_key in state")
 }
+
+func keyVaultImportId(state *terraform.State) (string, error) {
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type == "oci_kms_key" {
+			return fmt.Sprintf("vaults/%s/keys/%s", rs.Primary.Attributes["vault_id"], rs.Primary.ID), nil
+		}
+	}
+
+	return "", fmt.Errorf("unable to create import id as no resource of type oci_kms_key in state")
+}
+
+func TestUnitRestoreKmsKeyPendingDeletion(t *testing.T) {
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"compartment_id":      "ocid1.compartment.oc1..aaaa",
+		"display_name":        "Key C",
+		"management_endpoint": "https://management.example.com",
+		"key_shape": []interface{}{
+			map[string]interface{}{"algorithm": "AES", "length": 16},
+		},
+	})
+	assert.Nil(t, err)
+
+	planKey := func(state string) *terraform.InstanceDiff {
+		instanceState := &terraform.InstanceState{
+			ID: "ocid1.key.oc1..aaaa",
+			Attributes: map[string]string{
+				"id":                    "ocid1.key.oc1..aaaa",
+				"compartment_id":        "ocid1.compartment.oc1..aaaa",
+				"display_name":          "Key C",
+				"management_endpoint":   "https://management.example.com",
+				"key_shape.#":           "1",
+				"key_shape.0.algorithm": "AES",
+				"key_shape.0.length":    "16",
+				"desired_state":         state,
+				"state":                 state,
+			},
+		}
+		diff, err := KmsKeyResource().Diff(instanceState, terraform.NewResourceConfig(rawConfig), nil)
+		assert.Nil(t, err)
+		return diff
+	}
+
+	// A key that is pending deletion, but still declared, is planned to be enabled again in place
+	diff := planKey(string(oci_kms.KeyLifecycleStatePendingDeletion))
+	assert.NotNil(t, diff)
+	assert.False(t, diff.RequiresNew())
+	assert.Equal(t, string(oci_kms.KeyLifecycleStateEnabled), diff.Attributes["desired_state"].New)
+
+	// Nothing changes for an enabled key
+	diff = planKey(string(oci_kms.KeyLifecycleStateEnabled))
+	assert.True(t, diff == nil || diff.Attributes["desired_state"] == nil)
+}
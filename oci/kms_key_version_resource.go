@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
 	"log"
 	"net/url"
@@ -31,6 +32,7 @@ func KmsKeyVersionResource() *schema.Resource {
 		Timeouts: DefaultTimeout,
 		Create:   createKmsKeyVersion,
 		Read:     readKmsKeyVersion,
+		Update:   updateKmsKeyVersion,
 		Delete:   deleteKmsKeyVersion,
 		Schema: map[string]*schema.Schema{
 			// Required
@@ -39,18 +41,65 @@ func KmsKeyVersionResource() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+
+			// Optional
+			"key_source": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_kms.KeyVersionOriginInternal),
+					string(oci_kms.KeyVersionOriginExternal),
+				}, false),
+			},
 			"management_endpoint": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
-
-			// Optional
 			"time_of_deletion": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 			},
+			"vault_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"wrapped_import_key": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"key_material": {
+							Type:      schema.TypeString,
+							Required:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+						"wrapping_algorithm": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(oci_kms.WrappedImportKeyWrappingAlgorithmRsaOaepSha256),
+							}, false),
+						},
+
+						// Optional
+
+						// Computed
+					},
+				},
+			},
 
 			// Computed
 			"compartment_id": {
@@ -69,22 +118,33 @@ func KmsKeyVersionResource() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"vault_id": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
 		},
+		CustomizeDiff: restoreKmsKeyVersionPendingDeletion,
 	}
 }
 
+// Key versions that are pending deletion are kept in the state, and restored by cancelling their deletion when they
+// are still declared in the configuration
+func restoreKmsKeyVersionPendingDeletion(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !isKmsKeyVersionPendingDeletion(d.Get("state").(string)) {
+		return nil
+	}
+
+	return d.SetNew("state", string(oci_kms.KeyVersionLifecycleStateEnabled))
+}
+
+func isKmsKeyVersionPendingDeletion(state string) bool {
+	return state == string(oci_kms.KeyVersionLifecycleStatePendingDeletion) || state == string(oci_kms.KeyVersionLifecycleStateSchedulingDeletion)
+}
+
 func createKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
 	sync := &KmsKeyVersionResourceCrud{}
 	sync.D = d
-	endpoint, ok := d.GetOkExists("management_endpoint")
-	if !ok {
-		return fmt.Errorf("management endpoint missing")
+	endpoint, err := getKmsManagementEndpoint(d, m)
+	if err != nil {
+		return err
 	}
-	client, err := m.(*OracleClients).KmsManagementClient(endpoint.(string))
+	client, err := m.(*OracleClients).KmsManagementClient(endpoint)
 	if err != nil {
 		return err
 	}
@@ -96,23 +156,30 @@ func createKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
 func readKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
 	sync := &KmsKeyVersionResourceCrud{}
 	sync.D = d
-	endpoint, ok := d.GetOkExists("management_endpoint")
-	if !ok {
+	if _, ok := d.GetOkExists("management_endpoint"); !ok {
 		//Import use case:
 		id := d.Id()
 		regex, _ := regexp.Compile("^managementEndpoint/(.*)/keys/(.*)/keyVersions/(.*)$")
-		tokens := regex.FindStringSubmatch(id)
-		if len(tokens) == 4 {
-			endpoint = tokens[1]
-			d.Set("management_endpoint", endpoint)
+		vaultRegex, _ := regexp.Compile("^vaults/(.*)/keys/(.*)/keyVersions/(.*)$")
+		if tokens := regex.FindStringSubmatch(id); len(tokens) == 4 {
+			d.Set("management_endpoint", tokens[1])
+			d.Set("key_id", tokens[2])
+			d.Set("key_version_id", tokens[3])
+			d.SetId(getKeyVersionCompositeId(tokens[2], tokens[3]))
+		} else if tokens := vaultRegex.FindStringSubmatch(id); len(tokens) == 4 {
+			d.Set("vault_id", tokens[1])
 			d.Set("key_id", tokens[2])
 			d.Set("key_version_id", tokens[3])
 			d.SetId(getKeyVersionCompositeId(tokens[2], tokens[3]))
 		} else {
-			return fmt.Errorf("id %s should be of format: managementEndpoint/{managementEndpoint}/keys/{keyId}/keyVersions/{keyVersionId}", id)
+			return fmt.Errorf("id %s should be of format: vaults/{vaultId}/keys/{keyId}/keyVersions/{keyVersionId} or managementEndpoint/{managementEndpoint}/keys/{keyId}/keyVersions/{keyVersionId}", id)
 		}
 	}
-	client, err := m.(*OracleClients).KmsManagementClient(endpoint.(string))
+	endpoint, err := getKmsManagementEndpoint(d, m)
+	if err != nil {
+		return err
+	}
+	client, err := m.(*OracleClients).KmsManagementClient(endpoint)
 	if err != nil {
 		return err
 	}
@@ -121,6 +188,22 @@ func readKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
 	return ReadResource(sync)
 }
 
+func updateKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
+	sync := &KmsKeyVersionResourceCrud{}
+	sync.D = d
+	endpoint, err := getKmsManagementEndpoint(d, m)
+	if err != nil {
+		return err
+	}
+	client, err := m.(*OracleClients).KmsManagementClient(endpoint)
+	if err != nil {
+		return err
+	}
+	sync.Client = client
+
+	return UpdateResource(d, sync)
+}
+
 func deleteKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
 	// prevent kms version deletion as part of testing as version deletion is only applicable when the version is not the current version of the key
 	disableKmsVersionDeletion, _ := strconv.ParseBool(getEnvSettingWithDefault("disable_kms_version_delete", "false"))
@@ -130,11 +213,11 @@ func deleteKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
 
 	sync := &KmsKeyVersionResourceCrud{}
 	sync.D = d
-	endpoint, ok := d.GetOkExists("management_endpoint")
-	if !ok {
-		return fmt.Errorf("management endpoint missing")
+	endpoint, err := getKmsManagementEndpoint(d, m)
+	if err != nil {
+		return err
 	}
-	client, err := m.(*OracleClients).KmsManagementClient(endpoint.(string))
+	client, err := m.(*OracleClients).KmsManagementClient(endpoint)
 	if err != nil {
 		return err
 	}
@@ -167,6 +250,10 @@ func (s *KmsKeyVersionResourceCrud) CreatedTarget() []string {
 	}
 }
 
+func (s *KmsKeyVersionResourceCrud) IsEventuallyConsistent() bool {
+	return true
+}
+
 func (s *KmsKeyVersionResourceCrud) DeletedPending() []string {
 	return []string{
 		string(oci_kms.KeyVersionLifecycleStateDisabled),
@@ -182,7 +269,36 @@ func (s *KmsKeyVersionResourceCrud) DeletedTarget() []string {
 	}
 }
 
+func (s *KmsKeyVersionResourceCrud) UpdatedPending() []string {
+	return []string{
+		string(oci_kms.KeyVersionLifecycleStateCancellingDeletion),
+	}
+}
+
+func (s *KmsKeyVersionResourceCrud) UpdatedTarget() []string {
+	return []string{
+		string(oci_kms.KeyVersionLifecycleStateEnabled),
+		string(oci_kms.KeyVersionLifecycleStateDisabled),
+	}
+}
+
+// A key version that is pending deletion is not removed from the state, so that its deletion can be cancelled by an
+// update
+func (s *KmsKeyVersionResourceCrud) VoidState() {
+	if s.Res != nil && isKmsKeyVersionPendingDeletion(string(s.Res.LifecycleState)) {
+		return
+	}
+	s.D.SetId("")
+}
+
 func (s *KmsKeyVersionResourceCrud) Create() error {
+	if keySource, ok := s.D.GetOkExists("key_source"); ok && keySource.(string) == string(oci_kms.KeyVersionOriginExternal) {
+		return s.importKeyVersion()
+	}
+	if _, ok := s.D.GetOkExists("wrapped_import_key"); ok {
+		return fmt.Errorf("wrapped_import_key can only be set when key_source is %s", oci_kms.KeyVersionOriginExternal)
+	}
+
 	request := oci_kms.CreateKeyVersionRequest{}
 
 	if keyId, ok := s.D.GetOkExists("key_id"); ok {
@@ -196,8 +312,40 @@ func (s *KmsKeyVersionResourceCrud) Create() error {
 	if err != nil {
 		return err
 	}
-	//has to wait some time, otherwise subsequent querying will fail
-	time.Sleep(time.Second * 30)
+	s.Res = &response.KeyVersion
+	return nil
+}
+
+// importKeyVersion creates the key version from the key material in wrapped_import_key, which was wrapped with the
+// public key of the wrapping key of the vault
+func (s *KmsKeyVersionResourceCrud) importKeyVersion() error {
+	request := oci_kms.ImportKeyVersionRequest{}
+
+	if keyId, ok := s.D.GetOkExists("key_id"); ok {
+		tmp := keyId.(string)
+		request.KeyId = &tmp
+	}
+
+	if wrappedImportKey, ok := s.D.GetOkExists("wrapped_import_key"); ok {
+		if tmpList := wrappedImportKey.([]interface{}); len(tmpList) > 0 {
+			fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "wrapped_import_key", 0)
+			tmp, err := s.mapToWrappedImportKey(fieldKeyFormat)
+			if err != nil {
+				return err
+			}
+			request.WrappedImportKey = &tmp
+		}
+	}
+	if request.WrappedImportKey == nil {
+		return fmt.Errorf("wrapped_import_key must be set when key_source is %s", oci_kms.KeyVersionOriginExternal)
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
+
+	response, err := s.Client.ImportKeyVersion(context.Background(), request)
+	if err != nil {
+		return err
+	}
 	s.Res = &response.KeyVersion
 	return nil
 }
@@ -225,7 +373,41 @@ func (s *KmsKeyVersionResourceCrud) Get() error {
 	return nil
 }
 
+// Update restores a key version that is pending deletion. The time_of_deletion is only used when the key version is
+// deleted.
+func (s *KmsKeyVersionResourceCrud) Update() error {
+	// The state is planned to change when the key version is pending deletion
+	if oldState, _ := s.D.GetChange("state"); !isKmsKeyVersionPendingDeletion(oldState.(string)) {
+		return s.Get()
+	}
+
+	request := oci_kms.CancelKeyVersionDeletionRequest{}
+
+	keyId, keyVersionId, err := parseKeyVersionCompositeId(s.D.Id())
+	if err != nil {
+		return err
+	}
+	request.KeyId = &keyId
+	request.KeyVersionId = &keyVersionId
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
+
+	response, err := s.Client.CancelKeyVersionDeletion(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.KeyVersion
+	s.D.Set("time_of_deletion", "")
+	return nil
+}
+
 func (s *KmsKeyVersionResourceCrud) Delete() error {
+	// The deletion of a key version that is pending deletion is already scheduled
+	if isKmsKeyVersionPendingDeletion(s.D.Get("state").(string)) {
+		return nil
+	}
+
 	request := oci_kms.ScheduleKeyVersionDeletionRequest{}
 
 	keyId, keyVersionId, err := parseKeyVersionCompositeId(s.D.Id())
@@ -269,6 +451,10 @@ func (s *KmsKeyVersionResourceCrud) SetData() error {
 		s.D.Set("key_id", *s.Res.KeyId)
 	}
 
+	if s.Res.Origin != "" {
+		s.D.Set("key_source", s.Res.Origin)
+	}
+
 	s.D.Set("state", s.Res.LifecycleState)
 
 	if s.Res.TimeCreated != nil {
@@ -305,3 +491,18 @@ func parseKeyVersionCompositeId(compositeId string) (keyId string, keyVersionId
 
 	return
 }
+
+func (s *KmsKeyVersionResourceCrud) mapToWrappedImportKey(fieldKeyFormat string) (oci_kms.WrappedImportKey, error) {
+	result := oci_kms.WrappedImportKey{}
+
+	if keyMaterial, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "key_material")); ok {
+		tmp := keyMaterial.(string)
+		result.KeyMaterial = &tmp
+	}
+
+	if wrappingAlgorithm, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "wrapping_algorithm")); ok {
+		result.WrappingAlgorithm = oci_kms.WrappedImportKeyWrappingAlgorithmEnum(wrappingAlgorithm.(string))
+	}
+
+	return result, nil
+}
@@ -61,6 +61,7 @@ func TestResourceKmsKeyVersionVirtualResource_basic(t *testing.T) {
 					generateResourceFromRepresentationMap("oci_kms_key_version", "test_key_version", Required, Create, keyVersionVirtualRepresentation),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "key_id"),
+					resource.TestCheckResourceAttr(resourceName, "key_source", "INTERNAL"),
 					resource.TestCheckResourceAttrSet(resourceName, "management_endpoint"),
 				),
 			},
@@ -117,6 +118,17 @@ func TestResourceKmsKeyVersionVirtualResource_basic(t *testing.T) {
 				},
 				ResourceName: resourceName,
 			},
+			// verify resource import with the vault of the key version
+			{
+				Config:            config,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: keyVersionVaultImportId,
+				ImportStateVerifyIgnore: []string{
+					"time_of_deletion",
+				},
+				ResourceName: resourceName,
+			},
 		},
 	})
 }
@@ -8,8 +8,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	oci_kms "github.com/oracle/oci-go-sdk/keymanagement"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
 )
@@ -95,6 +98,24 @@ func TestKmsKeyVersionResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(datasourceName, "key_versions.0.vault_id"),
 				),
 			},
+			// verify datasource with the management endpoint looked up from vault_id
+			{
+				Config: config +
+					generateDataSourceFromRepresentationMap("oci_kms_key_versions", "test_key_versions", Optional, Update,
+						representationCopyWithNewProperties(representationCopyWithRemovedProperties(keyVersionDataSourceRepresentation, []string{"management_endpoint"}), map[string]interface{}{
+							"vault_id": Representation{repType: Required, create: `${data.oci_kms_vault.test_vault.id}`},
+						})) +
+					compartmentIdVariableStr + KeyVersionResourceDependencies +
+					generateResourceFromRepresentationMap("oci_kms_key_version", "test_key_version", Optional, Update, keyVersionRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "management_endpoint"),
+					resource.TestCheckResourceAttrSet(datasourceName, "vault_id"),
+
+					resource.TestCheckResourceAttr(datasourceName, "key_versions.#", "1"),
+					resource.TestCheckResourceAttrSet(datasourceName, "key_versions.0.id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "key_versions.0.key_source"),
+				),
+			},
 			// verify singular datasource
 			{
 				Config: config +
@@ -142,3 +163,47 @@ func keyVersionImportId(state *terraform.State) (string, error) {
 
 	return "", fmt.Errorf("unable to create import id as no resource of type oci_kms_key_version in state")
 }
+
+func keyVersionVaultImportId(state *terraform.State) (string, error) {
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type == "oci_kms_key_version" {
+			return fmt.Sprintf("vaults/%s/%s", rs.Primary.Attributes["vault_id"], rs.Primary.ID), nil
+		}
+	}
+
+	return "", fmt.Errorf("unable to create import id as no resource of type oci_kms_key_version in state")
+}
+
+func TestUnitRestoreKmsKeyVersionPendingDeletion(t *testing.T) {
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"key_id":              "ocid1.key.oc1..aaaa",
+		"management_endpoint": "https://management.example.com",
+	})
+	assert.Nil(t, err)
+
+	planKeyVersion := func(state string) *terraform.InstanceDiff {
+		instanceState := &terraform.InstanceState{
+			ID: "ocid1.keyversion.oc1..aaaa",
+			Attributes: map[string]string{
+				"id":                  "ocid1.keyversion.oc1..aaaa",
+				"key_id":              "ocid1.key.oc1..aaaa",
+				"management_endpoint": "https://management.example.com",
+				"key_source":          string(oci_kms.KeyVersionOriginInternal),
+				"state":               state,
+			},
+		}
+		diff, err := KmsKeyVersionResource().Diff(instanceState, terraform.NewResourceConfig(rawConfig), nil)
+		assert.Nil(t, err)
+		return diff
+	}
+
+	// A key version that is pending deletion, but still declared, is planned to be enabled again in place
+	diff := planKeyVersion(string(oci_kms.KeyVersionLifecycleStatePendingDeletion))
+	assert.NotNil(t, diff)
+	assert.False(t, diff.RequiresNew())
+	assert.Equal(t, string(oci_kms.KeyVersionLifecycleStateEnabled), diff.Attributes["state"].New)
+
+	// Nothing changes for an enabled key version
+	diff = planKeyVersion(string(oci_kms.KeyVersionLifecycleStateEnabled))
+	assert.True(t, diff == nil || diff.Attributes["state"] == nil)
+}
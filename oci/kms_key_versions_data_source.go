@@ -5,8 +5,6 @@ package oci
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/hashicorp/terraform/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/keymanagement"
 )
@@ -26,7 +24,12 @@ func KmsKeyVersionsDataSource() *schema.Resource {
 			},
 			"management_endpoint": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+			},
+			"vault_id": {
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 			"key_versions": {
 				Type:     schema.TypeList,
@@ -40,11 +43,11 @@ func KmsKeyVersionsDataSource() *schema.Resource {
 func readKmsKeyVersions(d *schema.ResourceData, m interface{}) error {
 	sync := &KmsKeyVersionsDataSourceCrud{}
 	sync.D = d
-	endpoint, ok := d.GetOkExists("management_endpoint")
-	if !ok {
-		return fmt.Errorf("management endpoint missing")
+	endpoint, err := getKmsManagementEndpoint(d, m)
+	if err != nil {
+		return err
 	}
-	client, err := m.(*OracleClients).KmsManagementClient(endpoint.(string))
+	client, err := m.(*OracleClients).KmsManagementClient(endpoint)
 	if err != nil {
 		return err
 	}
@@ -112,9 +115,12 @@ func (s *KmsKeyVersionsDataSourceCrud) SetData() error {
 		}
 
 		if r.Id != nil {
+			keyVersion["id"] = *r.Id
 			keyVersion["key_version_id"] = *r.Id
 		}
 
+		keyVersion["key_source"] = r.Origin
+
 		keyVersion["state"] = r.LifecycleState
 
 		if r.TimeCreated != nil {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
 	oci_common "github.com/oracle/oci-go-sdk/common"
 	oci_kms "github.com/oracle/oci-go-sdk/keymanagement"
@@ -40,6 +41,10 @@ func KmsVaultResource() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_kms.CreateVaultDetailsVaultTypeDefault),
+					string(oci_kms.CreateVaultDetailsVaultTypeVirtualPrivate),
+				}, false),
 			},
 
 			// Optional
@@ -210,15 +215,6 @@ func (s *KmsVaultResourceCrud) Get() error {
 }
 
 func (s *KmsVaultResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
 	request := oci_kms.UpdateVaultRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -272,6 +268,10 @@ func (s *KmsVaultResourceCrud) Delete() error {
 	return err
 }
 
+func (s *KmsVaultResourceCrud) IsDeletionProtected() bool {
+	return true
+}
+
 func (s *KmsVaultResourceCrud) SetData() error {
 	if s.Res.CompartmentId != nil {
 		s.D.Set("compartment_id", *s.Res.CompartmentId)
@@ -310,7 +310,7 @@ func (s *KmsVaultResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *KmsVaultResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *KmsVaultResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_kms.ChangeVaultCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -325,5 +325,7 @@ func (s *KmsVaultResourceCrud) updateCompartment(compartment interface{}) error
 	if err != nil {
 		return err
 	}
-	return nil
+
+	return waitForStateRefresh(s, s.D.Timeout(schema.TimeoutUpdate), "update",
+		[]string{string(oci_kms.VaultLifecycleStateUpdating)}, []string{string(oci_kms.VaultLifecycleStateActive)})
 }
@@ -0,0 +1,117 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_kms "github.com/oracle/oci-go-sdk/keymanagement"
+)
+
+func init() {
+	RegisterDatasource("oci_kms_wrapping_key", KmsWrappingKeyDataSource())
+}
+
+func KmsWrappingKeyDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readSingularKmsWrappingKey,
+		Schema: map[string]*schema.Schema{
+			"management_endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vault_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			// Computed
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func readSingularKmsWrappingKey(d *schema.ResourceData, m interface{}) error {
+	sync := &KmsWrappingKeyDataSourceCrud{}
+	sync.D = d
+	endpoint, err := getKmsManagementEndpoint(d, m)
+	if err != nil {
+		return err
+	}
+	client, err := m.(*OracleClients).KmsManagementClient(endpoint)
+	if err != nil {
+		return err
+	}
+	sync.Client = client
+
+	return ReadResource(sync)
+}
+
+type KmsWrappingKeyDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_kms.KmsManagementClient
+	Res    *oci_kms.GetWrappingKeyResponse
+}
+
+func (s *KmsWrappingKeyDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *KmsWrappingKeyDataSourceCrud) Get() error {
+	request := oci_kms.GetWrappingKeyRequest{}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "kms")
+
+	response, err := s.Client.GetWrappingKey(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	return nil
+}
+
+func (s *KmsWrappingKeyDataSourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.SetId(*s.Res.Id)
+
+	if s.Res.CompartmentId != nil {
+		s.D.Set("compartment_id", *s.Res.CompartmentId)
+	}
+
+	if s.Res.PublicKey != nil {
+		s.D.Set("public_key", *s.Res.PublicKey)
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.TimeCreated != nil {
+		s.D.Set("time_created", s.Res.TimeCreated.String())
+	}
+
+	if s.Res.VaultId != nil {
+		s.D.Set("vault_id", *s.Res.VaultId)
+	}
+
+	return nil
+}
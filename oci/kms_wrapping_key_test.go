@@ -0,0 +1,59 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+)
+
+var (
+	wrappingKeySingularDataSourceRepresentation = map[string]interface{}{
+		"vault_id": Representation{repType: Required, create: `${data.oci_kms_vault.test_vault.id}`},
+	}
+
+	WrappingKeyResourceConfig = KeyResourceDependencies
+)
+
+func TestKmsWrappingKeyResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestKmsWrappingKeyResource_basic")
+	defer httpreplay.SaveScenario()
+
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	singularDatasourceName := "data.oci_kms_wrapping_key.test_wrapping_key"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify singular datasource
+			{
+				Config: config +
+					generateDataSourceFromRepresentationMap("oci_kms_wrapping_key", "test_wrapping_key", Required, Create, wrappingKeySingularDataSourceRepresentation) +
+					compartmentIdVariableStr + WrappingKeyResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "vault_id"),
+
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "compartment_id"),
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "id"),
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "management_endpoint"),
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "public_key"),
+					resource.TestCheckResourceAttr(singularDatasourceName, "state", "ENABLED"),
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "time_created"),
+				),
+			},
+		},
+	})
+}
@@ -4,6 +4,7 @@ package oci
 
 import (
 	"context"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -43,7 +44,7 @@ func LimitsQuotaResource() *schema.Resource {
 			"statements": {
 				Type:             schema.TypeList,
 				Required:         true,
-				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+				DiffSuppressFunc: ignoreQuotaStatementFormatDiff,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -298,3 +299,13 @@ func (s *LimitsQuotaResourceCrud) SetData() error {
 
 	return nil
 }
+
+// The service normalizes quota statements on write, so statements that differ only in
+// case or whitespace should not produce a diff.
+func ignoreQuotaStatementFormatDiff(key string, old string, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(normalizeQuotaStatement(old), normalizeQuotaStatement(new))
+}
+
+func normalizeQuotaStatement(statement string) string {
+	return strings.Join(strings.Fields(statement), " ")
+}
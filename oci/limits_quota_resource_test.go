@@ -0,0 +1,28 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"testing"
+)
+
+func TestUnitLimitsQuota_ignoreQuotaStatementFormatDiff(t *testing.T) {
+	tests := []struct {
+		old          string
+		new          string
+		suppressDiff bool
+	}{
+		{`Set compute quotas to 0 in tenancy`, `Set compute quotas to 0 in tenancy`, true},
+		{`Set compute quotas to 0 in tenancy`, `set COMPUTE quotas to 0 in tenancy`, true},
+		{`Set compute quotas to 0 in tenancy`, `  Set compute  quotas to 0
+			in tenancy `, true},
+		{`Set compute quotas to 0 in tenancy`, `Set compute quotas to 10 in tenancy`, false},
+		{`Set compute quotas to 0 in tenancy`, ``, false},
+	}
+
+	for _, test := range tests {
+		if result := ignoreQuotaStatementFormatDiff("statements.0", test.old, test.new, nil); result != test.suppressDiff {
+			t.Errorf("expected diff suppression to be %t for '%s' and '%s', got %t", test.suppressDiff, test.old, test.new, result)
+		}
+	}
+}
@@ -5,6 +5,7 @@ package oci
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -128,9 +129,23 @@ func LoadBalancerLoadBalancerResource() *schema.Resource {
 				Computed: true,
 			},
 		},
+		CustomizeDiff: checkLoadBalancerLoadBalancerServiceLimits,
 	}
 }
 
+// Load balancers are limited by shape, e.g. by lb-100mbps-count for the 100Mbps shape
+func checkLoadBalancerLoadBalancerServiceLimits(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("shape") {
+		return nil
+	}
+
+	return checkServiceLimits(d, meta, serviceLimitUsage{
+		ServiceName: "load-balancer",
+		LimitName:   fmt.Sprintf("lb-%s-count", strings.ToLower(d.Get("shape").(string))),
+		Amount:      1,
+	})
+}
+
 func createLoadBalancerLoadBalancer(d *schema.ResourceData, m interface{}) error {
 	sync := &LoadBalancerLoadBalancerResourceCrud{}
 	sync.D = d
@@ -296,7 +311,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Create() error {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"), s.D.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -333,16 +348,6 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Get() error {
 }
 
 func (s *LoadBalancerLoadBalancerResourceCrud) Update() error {
-	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
-		oldRaw, newRaw := s.D.GetChange("compartment_id")
-		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
-			if err != nil {
-				return err
-			}
-		}
-	}
-
 	if s.D.HasChange("network_security_group_ids") {
 		err := s.updateNetworkSecurityGroups()
 		if err != nil {
@@ -387,7 +392,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Update() error {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"), s.D.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return err
 	}
@@ -417,7 +422,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Delete() error {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"), s.D.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -499,7 +504,7 @@ func IpAddressToMap(obj oci_load_balancer.IpAddress) map[string]interface{} {
 	return result
 }
 
-func (s *LoadBalancerLoadBalancerResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *LoadBalancerLoadBalancerResourceCrud) ChangeCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_load_balancer.ChangeLoadBalancerCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -510,11 +515,24 @@ func (s *LoadBalancerLoadBalancerResourceCrud) updateCompartment(compartment int
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	_, err := s.Client.ChangeLoadBalancerCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeLoadBalancerCompartment(context.Background(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
-	return nil
+
+	workReqID := response.OpcWorkRequestId
+	if workReqID == nil {
+		return nil
+	}
+	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
+	getWorkRequestRequest.WorkRequestId = workReqID
+	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	if err != nil {
+		return err
+	}
+	s.WorkRequest = &workRequestResponse.WorkRequest
+	return LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"), s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *LoadBalancerLoadBalancerResourceCrud) updateNetworkSecurityGroups() error {
@@ -552,7 +570,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) updateNetworkSecurityGroups() err
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"), s.D.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return err
 	}
@@ -15,9 +15,10 @@ func init() {
 
 func MarketplaceListingPackageAgreementResource() *schema.Resource {
 	return &schema.Resource{
-		Create: createMarketplaceListingPackageAgreement,
-		Read:   readMarketplaceListingPackageAgreement,
-		Delete: deleteMarketplaceListingPackageAgreement,
+		Timeouts: DefaultTimeout,
+		Create:   createMarketplaceListingPackageAgreement,
+		Read:     readMarketplaceListingPackageAgreement,
+		Delete:   deleteMarketplaceListingPackageAgreement,
 		Schema: map[string]*schema.Schema{
 			"agreement_id": {
 				Type:     schema.TypeString,
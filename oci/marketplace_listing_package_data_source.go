@@ -243,7 +243,7 @@ func (s *MarketplaceListingPackageDataSourceCrud) SetData() error {
 		}
 
 		if v.ListingId != nil {
-			s.D.Set("Listing_id", v.ListingId)
+			s.D.Set("listing_id", v.ListingId)
 		}
 
 		s.D.Set("package_type", oci_marketplace.PackageTypeEnumImage)
@@ -277,7 +277,7 @@ func (s *MarketplaceListingPackageDataSourceCrud) SetData() error {
 		}
 
 		if v.ListingId != nil {
-			s.D.Set("Listing_id", v.ListingId)
+			s.D.Set("listing_id", v.ListingId)
 		}
 
 		s.D.Set("package_type", oci_marketplace.PackageTypeEnumOrchestration)
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"time"
 
@@ -16,6 +17,8 @@ import (
 	oci_nosql "github.com/oracle/oci-go-sdk/nosql"
 )
 
+var ddlStatementPunctuationSpaceRegex = regexp.MustCompile(`\s*([(),])\s*`)
+
 func init() {
 	RegisterResource("oci_nosql_table", NosqlTableResource())
 }
@@ -39,7 +42,7 @@ func NosqlTableResource() *schema.Resource {
 			"ddl_statement": {
 				Type:             schema.TypeString,
 				Required:         true,
-				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+				DiffSuppressFunc: ddlStatementDiffSuppressFunction,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -384,6 +387,7 @@ func tableWaitForWorkRequest(wId *string, entityType string, action oci_nosql.Wo
 		},
 		Timeout: timeout,
 	}
+	setPollInterval(stateConf, providerPollInterval)
 	if _, e := stateConf.WaitForState(); e != nil {
 		return nil, e
 	}
@@ -422,11 +426,23 @@ func getErrorFromTableWorkRequest(client *oci_nosql.NosqlClient, wId *string, re
 	for _, wrkErr := range response.Items {
 		allErrs = append(allErrs, *wrkErr.Message)
 	}
-	errorMessage := strings.Join(allErrs, "\n")
 
-	workRequestErr := fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *wId, entityType, action, errorMessage)
+	logs := make([]string, 0)
+	logsResponse, err := client.ListWorkRequestLogs(context.Background(),
+		oci_nosql.ListWorkRequestLogsRequest{
+			WorkRequestId: wId,
+			RequestMetadata: oci_common.RequestMetadata{
+				RetryPolicy: retryPolicy,
+			},
+		})
+	if err != nil {
+		log.Printf("[WARN] Could not list the log entries of work request %s: %v", *wId, err)
+	}
+	for _, logEntry := range logsResponse.Items {
+		logs = append(logs, *logEntry.Message)
+	}
 
-	return workRequestErr
+	return newWorkRequestError(wId, entityType, string(action), response.OpcRequestId, allErrs, logs)
 }
 
 func (s *NosqlTableResourceCrud) Get() error {
@@ -790,3 +806,14 @@ func (s *NosqlTableResourceCrud) updateCompartment(fromCompartmentId, toCompartm
 	}
 	return nil
 }
+
+// Formatting differences in the DDL statement (case, whitespace and spacing around
+// parentheses and commas) should not result in an ALTER of the table.
+func ddlStatementDiffSuppressFunction(key string, old string, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(normalizeDdlStatement(old), normalizeDdlStatement(new))
+}
+
+func normalizeDdlStatement(ddlStatement string) string {
+	normalized := strings.Join(strings.Fields(ddlStatement), " ")
+	return ddlStatementPunctuationSpaceRegex.ReplaceAllString(normalized, "$1")
+}
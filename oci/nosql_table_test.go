@@ -358,3 +358,27 @@ func tableSweepResponseFetchOperation(client *OracleClients, resourceId *string,
 	})
 	return err
 }
+
+func TestUnitNosqlTable_ddlStatementDiffSuppressFunction(t *testing.T) {
+	ddlStatement := "CREATE TABLE IF NOT EXISTS test_table(id INTEGER, name STRING, PRIMARY KEY(SHARD(id)))"
+	tests := []struct {
+		new          string
+		suppressDiff bool
+	}{
+		{ddlStatement, true},
+		{"create table if not exists test_table(id integer, name string, primary key(shard(id)))", true},
+		{`CREATE TABLE IF NOT EXISTS test_table (
+			id INTEGER,
+			name STRING,
+			PRIMARY KEY ( SHARD ( id ) )
+		)`, true},
+		{"CREATE TABLE IF NOT EXISTS test_table(id INTEGER, name STRING, age INTEGER, PRIMARY KEY(SHARD(id)))", false},
+		{"CREATE TABLE IF NOT EXISTS test_table2(id INTEGER, name STRING, PRIMARY KEY(SHARD(id)))", false},
+	}
+
+	for _, test := range tests {
+		if result := ddlStatementDiffSuppressFunction("ddl_statement", ddlStatement, test.new, nil); result != test.suppressDiff {
+			t.Errorf("expected diff suppression to be %t for '%s', got %t", test.suppressDiff, test.new, result)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+)
+
+var (
+	tableUsageDataSourceRepresentation = map[string]interface{}{
+		"table_name_or_id": Representation{repType: Required, create: `${oci_nosql_table.test_table.id}`},
+		"compartment_id":   Representation{repType: Required, create: `${var.compartment_id}`},
+		"time_start":       Representation{repType: Optional, create: `2020-01-01T00:00:00Z`},
+		"time_end":         Representation{repType: Optional, create: `2030-01-01T00:00:00Z`},
+	}
+
+	TableUsageResourceConfig = TableResourceDependencies +
+		generateResourceFromRepresentationMap("oci_nosql_table", "test_table", Required, Create, tableRepresentation)
+)
+
+func TestNosqlTableUsageResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestNosqlTableUsageResource_basic")
+	defer httpreplay.SaveScenario()
+
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	datasourceName := "data.oci_nosql_table_usages.test_table_usages"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify datasource
+			{
+				Config: config +
+					generateDataSourceFromRepresentationMap("oci_nosql_table_usages", "test_table_usages", Optional, Create, tableUsageDataSourceRepresentation) +
+					compartmentIdVariableStr + TableUsageResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "compartment_id", compartmentId),
+					resource.TestCheckResourceAttrSet(datasourceName, "table_name_or_id"),
+					resource.TestCheckResourceAttr(datasourceName, "time_start", "2020-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(datasourceName, "time_end", "2030-01-01T00:00:00Z"),
+
+					resource.TestCheckResourceAttrSet(datasourceName, "table_usage_collection.#"),
+				),
+			},
+		},
+	})
+}
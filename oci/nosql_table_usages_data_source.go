@@ -0,0 +1,207 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_nosql "github.com/oracle/oci-go-sdk/nosql"
+)
+
+func init() {
+	RegisterDatasource("oci_nosql_table_usages", NosqlTableUsagesDataSource())
+}
+
+func NosqlTableUsagesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readNosqlTableUsages,
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"table_name_or_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"time_end": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"time_start": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"table_usage_collection": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+
+						// Computed
+						"read_throttle_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"read_units": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"seconds_in_period": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"storage_in_gbs": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"storage_throttle_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"write_throttle_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"write_units": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readNosqlTableUsages(d *schema.ResourceData, m interface{}) error {
+	sync := &NosqlTableUsagesDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).nosqlClient
+
+	return ReadResource(sync)
+}
+
+type NosqlTableUsagesDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_nosql.NosqlClient
+	Res    *oci_nosql.ListTableUsageResponse
+}
+
+func (s *NosqlTableUsagesDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *NosqlTableUsagesDataSourceCrud) Get() error {
+	request := oci_nosql.ListTableUsageRequest{}
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	if tableNameOrId, ok := s.D.GetOkExists("table_name_or_id"); ok {
+		tmp := tableNameOrId.(string)
+		request.TableNameOrId = &tmp
+	}
+
+	if timeEnd, ok := s.D.GetOkExists("time_end"); ok {
+		tmp, err := time.Parse(time.RFC3339, timeEnd.(string))
+		if err != nil {
+			return err
+		}
+		request.TimeEnd = &oci_common.SDKTime{Time: tmp}
+	}
+
+	if timeStart, ok := s.D.GetOkExists("time_start"); ok {
+		tmp, err := time.Parse(time.RFC3339, timeStart.(string))
+		if err != nil {
+			return err
+		}
+		request.TimeStart = &oci_common.SDKTime{Time: tmp}
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "nosql")
+
+	response, err := s.Client.ListTableUsage(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	request.Page = s.Res.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.ListTableUsage(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res.Items = append(s.Res.Items, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	return nil
+}
+
+func (s *NosqlTableUsagesDataSourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.SetId(GenerateDataSourceID())
+
+	resources := []map[string]interface{}{}
+	for _, item := range s.Res.Items {
+		resources = append(resources, TableUsageSummaryToMap(item))
+	}
+
+	if f, fOk := s.D.GetOkExists("filter"); fOk {
+		resources = ApplyFilters(f.(*schema.Set), resources, NosqlTableUsagesDataSource().Schema["table_usage_collection"].Elem.(*schema.Resource).Schema)
+	}
+
+	s.D.Set("table_usage_collection", resources)
+
+	return nil
+}
+
+func TableUsageSummaryToMap(obj oci_nosql.TableUsageSummary) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	if obj.ReadThrottleCount != nil {
+		result["read_throttle_count"] = int(*obj.ReadThrottleCount)
+	}
+
+	if obj.ReadUnits != nil {
+		result["read_units"] = int(*obj.ReadUnits)
+	}
+
+	if obj.SecondsInPeriod != nil {
+		result["seconds_in_period"] = int(*obj.SecondsInPeriod)
+	}
+
+	if obj.StorageInGBs != nil {
+		result["storage_in_gbs"] = int(*obj.StorageInGBs)
+	}
+
+	if obj.StorageThrottleCount != nil {
+		result["storage_throttle_count"] = int(*obj.StorageThrottleCount)
+	}
+
+	if obj.WriteThrottleCount != nil {
+		result["write_throttle_count"] = int(*obj.WriteThrottleCount)
+	}
+
+	if obj.WriteUnits != nil {
+		result["write_units"] = int(*obj.WriteUnits)
+	}
+
+	return result
+}
@@ -493,6 +493,12 @@ func (s *ObjectStorageBucketResourceCrud) Delete() error {
 	return err
 }
 
+// Empty buckets can be deleted while deletion protection is enabled
+func (s *ObjectStorageBucketResourceCrud) IsDeletionProtected() bool {
+	approximateCount, _ := s.D.Get("approximate_count").(string)
+	return approximateCount != "0"
+}
+
 func (s *ObjectStorageBucketResourceCrud) SetData() error {
 
 	s.D.Set("bucket_id", *s.Res.Id)
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
 )
 
@@ -30,6 +31,11 @@ func ObjectStorageObjectsDataSource() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"namespace": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -168,7 +174,8 @@ func (s *ObjectStorageObjectsDataSourceCrud) Get() error {
 			s.Res.Objects = append(s.Res.Objects, objectSummary)
 		}
 
-		if response.NextStartWith == nil || *response.NextStartWith == "" {
+		// The service returns at most limit objects, so the next page is only listed when there is no limit
+		if response.NextStartWith == nil || *response.NextStartWith == "" || request.Limit != nil {
 			break
 		}
 
@@ -0,0 +1,121 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// OCIDs have the form ocid1.<resource type>.<realm>.[region][.future use].<unique id>
+var ocidRegex = regexp.MustCompile(`^ocid1\.([a-z0-9-]+)\.[a-z0-9]+\.[a-z0-9-]*(\.[a-z0-9-]+)?\.[a-zA-Z0-9_-]+$`)
+
+// Attributes named *_id that do not take an OCID
+var nonOcidIdAttributes = map[string]bool{
+	"accepted_agreement_id":  true,
+	"agreement_id":           true,
+	"cluster_option_id":      true,
+	"cpe_device_shape_id":    true,
+	"listing_id":             true,
+	"node_pool_option_id":    true,
+	"par_id":                 true,
+	"probe_configuration_id": true,
+	"publisher_id":           true,
+	"replication_id":         true,
+	"source_version_id":      true,
+	"table_name_or_id":       true,
+	"version_id":             true,
+	"vlan_id":                true,
+	"zone_name_or_id":        true,
+}
+
+// The resource types of the OCIDs expected by common attributes, used to catch an OCID of the wrong resource being passed
+var ocidTypesByAttribute = map[string][]string{
+	"backup_subnet_id":             {"subnet"},
+	"boot_volume_id":               {"bootvolume"},
+	"compartment_id":               {"compartment", "tenancy"},
+	"db_system_id":                 {"dbsystem"},
+	"default_s3compartment_id":     {"compartment", "tenancy"},
+	"default_swift_compartment_id": {"compartment", "tenancy"},
+	"dhcp_options_id":              {"dhcpoptions"},
+	"drg_id":                       {"drg"},
+	"group_id":                     {"group"},
+	"image_id":                     {"image"},
+	"instance_id":                  {"instance"},
+	"key_id":                       {"key"},
+	"kms_key_id":                   {"key"},
+	"load_balancer_id":             {"loadbalancer"},
+	"metric_compartment_id":        {"compartment", "tenancy"},
+	"network_security_group_id":    {"networksecuritygroup"},
+	"peer_db_system_id":            {"dbsystem"},
+	"primary_subnet_id":            {"subnet"},
+	"private_ip_id":                {"privateip"},
+	"route_table_id":               {"routetable"},
+	"subnet_id":                    {"subnet"},
+	"target_compartment_id":        {"compartment", "tenancy"},
+	"tenancy_id":                   {"tenancy"},
+	"topic_id":                     {"onstopic"},
+	"user_id":                      {"user"},
+	"vault_id":                     {"vault"},
+	"vcn_id":                       {"vcn"},
+	"vnic_id":                      {"vnic"},
+	"volume_id":                    {"volume"},
+}
+
+// addOcidValidation validates the format of the OCIDs set in the string arguments named *_id of a resource or data
+// source, and for common arguments that the OCID is of the expected resource type, so that a mistyped or
+// copy-pasted OCID fails the plan instead of failing part way through an apply.
+// Arguments that already have a ValidateFunc of their own are left as they are.
+func addOcidValidation(resource *schema.Resource) {
+	if resource == nil {
+		return
+	}
+	for name, fieldSchema := range resource.Schema {
+		if nestedResource, ok := fieldSchema.Elem.(*schema.Resource); ok {
+			addOcidValidation(nestedResource)
+		}
+
+		if !strings.HasSuffix(name, "_id") || nonOcidIdAttributes[name] {
+			continue
+		}
+		if fieldSchema.Type != schema.TypeString || !(fieldSchema.Required || fieldSchema.Optional) || fieldSchema.ValidateFunc != nil {
+			continue
+		}
+		fieldSchema.ValidateFunc = validateOcid(ocidTypesByAttribute[name]...)
+	}
+}
+
+// validateOcid checks that a value is an OCID, and if resource types are given, that it is an OCID of one of them.
+// Empty values are not validated, since they are used to leave optional arguments unset.
+func validateOcid(resourceTypes ...string) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+		if v == "" {
+			return
+		}
+
+		match := ocidRegex.FindStringSubmatch(v)
+		if match == nil {
+			es = append(es, fmt.Errorf("expected %s to be an OCID of the form ocid1.<resource type>.<realm>.[region].<unique id>, got %s", k, v))
+			return
+		}
+
+		if len(resourceTypes) == 0 {
+			return
+		}
+		for _, resourceType := range resourceTypes {
+			if match[1] == resourceType {
+				return
+			}
+		}
+		es = append(es, fmt.Errorf("expected %s to be the OCID of a %s, got the OCID of a %s: %s", k, strings.Join(resourceTypes, " or "), match[1], v))
+		return
+	}
+}
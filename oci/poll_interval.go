@@ -0,0 +1,67 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+)
+
+const (
+	// The first wait between refreshes when poll_interval is set, the wait then doubles up to poll_interval
+	minPollWait = time.Second
+	// DB systems and Exadata infrastructure take hours to provision, they are not polled more often than this once
+	// poll_interval is set
+	longRunningPollInterval = time.Minute
+)
+
+// The longest wait between refreshes while waiting for a lifecycle state, as set by poll_interval in the provider.
+// The default backoff of the state refresh, up to 10 seconds, is used when it is not set.
+var providerPollInterval time.Duration
+
+// pollIntervalFor returns the longest wait between refreshes of a resource, which is the poll interval of the
+// provider unless the resource polls less often. Resources keep the default backoff when poll_interval is not set.
+func pollIntervalFor(sync interface{}) time.Duration {
+	if providerPollInterval <= 0 {
+		return 0
+	}
+	if r, ok := sync.(PollIntervalResource); ok && r.PollInterval() > providerPollInterval {
+		return r.PollInterval()
+	}
+	return providerPollInterval
+}
+
+// setPollInterval makes a state refresh back off exponentially, starting at minPollWait, up to interval between
+// refreshes instead of up to the 10 seconds of its default backoff. The state refresh is left as it is when
+// interval is not set.
+func setPollInterval(stateConf *resource.StateChangeConf, interval time.Duration) {
+	if interval <= 0 || httpreplay.ShouldRetryImmediately() {
+		return
+	}
+
+	refresh := stateConf.Refresh
+	var wait time.Duration
+	stateConf.Refresh = func() (interface{}, string, error) {
+		time.Sleep(wait)
+		wait = nextPollWait(wait, interval)
+		return refresh()
+	}
+	// The waits of the refresh function replace the backoff of the state refresh
+	stateConf.PollInterval = time.Millisecond
+	stateConf.MinTimeout = 0
+}
+
+func nextPollWait(wait time.Duration, interval time.Duration) time.Duration {
+	if wait == 0 {
+		wait = minPollWait
+	} else {
+		wait *= 2
+	}
+	if wait > interval {
+		return interval
+	}
+	return wait
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+type testPollIntervalResource struct {
+	interval time.Duration
+}
+
+func (r *testPollIntervalResource) PollInterval() time.Duration {
+	return r.interval
+}
+
+func TestUnitNextPollWait(t *testing.T) {
+	var waits []time.Duration
+	var wait time.Duration
+	for i := 0; i < 6; i++ {
+		wait = nextPollWait(wait, 10*time.Second)
+		waits = append(waits, wait)
+	}
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second}, waits)
+}
+
+func TestUnitPollIntervalFor(t *testing.T) {
+	defer func() { providerPollInterval = 0 }()
+
+	// The default backoff is kept when poll_interval is not set
+	providerPollInterval = 0
+	assert.Equal(t, time.Duration(0), pollIntervalFor(&TestResource{}))
+	assert.Equal(t, time.Duration(0), pollIntervalFor(&testPollIntervalResource{interval: time.Minute}))
+
+	// Long running resources are not polled more often than their own interval
+	providerPollInterval = 30 * time.Second
+	assert.Equal(t, 30*time.Second, pollIntervalFor(&TestResource{}))
+	assert.Equal(t, time.Minute, pollIntervalFor(&testPollIntervalResource{interval: time.Minute}))
+
+	// but are polled less often when the provider is
+	providerPollInterval = 2 * time.Minute
+	assert.Equal(t, 2*time.Minute, pollIntervalFor(&testPollIntervalResource{interval: time.Minute}))
+}
+
+func TestUnitSetPollInterval(t *testing.T) {
+	newStateConf := func(refreshes *[]time.Time) *resource.StateChangeConf {
+		return &resource.StateChangeConf{
+			Pending: []string{"PROVISIONING"},
+			Target:  []string{"AVAILABLE"},
+			Refresh: func() (interface{}, string, error) {
+				*refreshes = append(*refreshes, time.Now())
+				if len(*refreshes) < 3 {
+					return "resource", "PROVISIONING", nil
+				}
+				return "resource", "AVAILABLE", nil
+			},
+			Timeout: time.Minute,
+		}
+	}
+
+	// The state refresh is left as it is when no interval is set
+	var refreshes []time.Time
+	stateConf := newStateConf(&refreshes)
+	setPollInterval(stateConf, 0)
+	assert.Equal(t, time.Duration(0), stateConf.PollInterval)
+
+	// Otherwise refreshes back off from minPollWait up to the interval
+	stateConf = newStateConf(&refreshes)
+	setPollInterval(stateConf, time.Second)
+	assert.Equal(t, time.Millisecond, stateConf.PollInterval)
+	_, err := stateConf.WaitForState()
+	assert.Nil(t, err)
+	assert.Len(t, refreshes, 3)
+	assert.True(t, refreshes[1].Sub(refreshes[0]) >= time.Second)
+	assert.True(t, refreshes[2].Sub(refreshes[1]) >= time.Second)
+	assert.True(t, refreshes[2].Sub(refreshes[1]) < 2*time.Second)
+}
@@ -25,6 +25,7 @@ import (
 	"crypto/x509"
 	"net"
 	"net/http"
+	"net/url"
 	"runtime"
 
 	oci_common "github.com/oracle/oci-go-sdk/common"
@@ -39,10 +40,24 @@ var ociProvider *schema.Provider
 
 var terraformCLIVersion = unknownTerraformCLIVersion
 var avoidWaitingForDeleteTarget bool
+var customEndpoints map[string]string
 
 type ConfigureClient func(client *oci_common.BaseClient) error
 
 var configureClient ConfigureClient // global fn ref used to configure all clients initially and others later on
+var configuredOboToken string
+var configuredAuth string
+
+// Second level domains of the realms known to the provider, the SDK resolves the regions of these realms on its own
+var realmDomains = map[string]string{
+	"oc1": "oraclecloud.com",
+	"oc2": "oraclegovcloud.com",
+	"oc3": "oraclegovcloud.com",
+	"oc4": "oraclegovcloud.uk",
+}
+
+// Second level domains of the regions in region_realms, for regions the SDK does not know about yet
+var regionDomains map[string]string
 
 var OciResources map[string]*schema.Resource
 var OciDatasources map[string]*schema.Resource
@@ -51,6 +66,9 @@ const (
 	authAPIKeySetting                     = "ApiKey"
 	authInstancePrincipalSetting          = "InstancePrincipal"
 	authInstancePrincipalWithCertsSetting = "InstancePrincipalWithCerts"
+	authResourcePrincipalSetting          = "ResourcePrincipal"
+	authSecurityTokenSetting              = "SecurityToken"
+	authTokenExchangeSetting              = "TokenExchange"
 	requestHeaderOpcOboToken              = "opc-obo-token"
 	requestHeaderOpcHostSerial            = "opc-host-serial"
 	defaultRequestTimeout                 = 0
@@ -64,6 +82,7 @@ const (
 	domainNameOverrideEnv                 = "domain_name_override"
 	customCertLocationEnv                 = "custom_cert_location"
 	acceptLocalCerts                      = "accept_local_certs"
+	delegationTokenFileEnv                = "OCI_DELEGATION_TOKEN_FILE"
 
 	authAttrName                 = "auth"
 	tenancyOcidAttrName          = "tenancy_ocid"
@@ -75,8 +94,21 @@ const (
 	regionAttrName               = "region"
 	disableAutoRetriesAttrName   = "disable_auto_retries"
 	retryDurationSecondsAttrName = "retry_duration_seconds"
+	maxRetriesAttrName           = "max_retries"
+	retryableStatusCodesAttrName = "retryable_status_codes"
+	retryOverridesAttrName       = "retry_overrides"
+	consistencyWindowAttrName    = "consistency_window_seconds"
+	maxRequestsPerSecondAttrName = "max_requests_per_second"
+	defaultTagsAttrName          = "default_tags"
+	ignoreDefinedTagsAttrName    = "ignore_defined_tags"
+	regionRealmsAttrName         = "region_realms"
+	realmDomainsAttrName         = "realm_domains"
 	oboTokenAttrName             = "obo_token"
 	configFileProfileAttrName    = "config_file_profile"
+	customEndpointsAttrName      = "custom_endpoints"
+	deletionProtectionAttrName   = "deletion_protection"
+	checkServiceLimitsAttrName   = "check_service_limits"
+	pollIntervalAttrName         = "poll_interval"
 
 	tfEnvPrefix           = "TF_VAR_"
 	ociEnvPrefix          = "OCI_"
@@ -98,12 +130,34 @@ func (provider emptyOboTokenProvider) OboToken() (string, error) {
 	return "", nil
 }
 
+// oboTokenProviderFromValue provides the obo token set with obo_token in the provider block
+type oboTokenProviderFromValue struct {
+	token string
+}
+
+func (p oboTokenProviderFromValue) OboToken() (string, error) {
+	return p.token, nil
+}
+
 type oboTokenProviderFromEnv struct{}
 
 func (p oboTokenProviderFromEnv) OboToken() (string, error) {
 	return getEnvSettingWithBlankDefault(oboTokenAttrName), nil
 }
 
+// oboTokenProviderFromFile reads the token on every request, since Cloud Shell refreshes the delegation token file in place
+type oboTokenProviderFromFile struct {
+	path string
+}
+
+func (p oboTokenProviderFromFile) OboToken() (string, error) {
+	token, err := ioutil.ReadFile(p.path)
+	if err != nil {
+		return "", fmt.Errorf("can not read delegation token from: '%s', Error: %q", p.path, err)
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
 func tfVarName(attrName string) string {
 	return tfEnvPrefix + attrName
 }
@@ -114,8 +168,8 @@ func ociVarName(attrName string) string {
 
 func init() {
 	descriptions = map[string]string{
-		authAttrName:        fmt.Sprintf("(Optional) The type of auth to use. Options are '%s' and '%s'. By default, '%s' will be used.", authAPIKeySetting, authInstancePrincipalSetting, authAPIKeySetting),
-		tenancyOcidAttrName: fmt.Sprintf("(Optional) The tenancy OCID for a user. The tenancy OCID can be found at the bottom of user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", authAPIKeySetting),
+		authAttrName:        fmt.Sprintf("(Optional) The type of auth to use. Options are '%s', '%s', '%s', '%s', '%s' and '%s'. By default, '%s' will be used.", authAPIKeySetting, authInstancePrincipalSetting, authInstancePrincipalWithCertsSetting, authResourcePrincipalSetting, authSecurityTokenSetting, authTokenExchangeSetting, authAPIKeySetting),
+		tenancyOcidAttrName: fmt.Sprintf("(Optional) The tenancy OCID for a user. The tenancy OCID can be found at the bottom of user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s' or '%s', ignored otherwise.", authAPIKeySetting, authTokenExchangeSetting),
 		userOcidAttrName:    fmt.Sprintf("(Optional) The user OCID. This can be found in user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", authAPIKeySetting),
 		fingerprintAttrName: fmt.Sprintf("(Optional) The fingerprint for the user's RSA key. This can be found in user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", authAPIKeySetting),
 		regionAttrName:      "(Required) The region for API connections (e.g. us-ashburn-1).",
@@ -128,7 +182,33 @@ func init() {
 			"Automatic retries were introduced to solve some eventual consistency problems but it also introduced performance issues on destroy operations.",
 		retryDurationSecondsAttrName: "(Optional) The minimum duration (in seconds) to retry a resource operation in response to an error.\n" +
 			"The actual retry duration may be longer due to jittering of retry operations. This value is ignored if the `disable_auto_retries` field is set to true.",
+		maxRetriesAttrName: "(Optional) The maximum number of times to retry a request in response to an error. A request is retried\n" +
+			"until the retry duration expires if this is not set. This value is ignored if the `disable_auto_retries` field is set to true.",
+		retryableStatusCodesAttrName: "(Optional) A list of HTTP status codes that are always retried, in addition to the ones retried by default.\n" +
+			"This value is ignored if the `disable_auto_retries` field is set to true.",
+		retryOverridesAttrName: "(Optional) A map of service name (e.g. identity, database) to the duration (in seconds) to retry operations of that service\n" +
+			"in response to an error, 0 disables retries for the service. This value is ignored if the `disable_auto_retries` field is set to true.",
+		consistencyWindowAttrName: "(Optional) The duration (in seconds) to keep waiting for a resource that is not found right after it is created,\n" +
+			"for resources that are eventually consistent such as identity policies, compartments and KMS keys. The default is 120 seconds.",
+		maxRequestsPerSecondAttrName: "(Optional) The maximum number of requests per second sent to each service endpoint. Requests are not limited if this is not set.",
+		defaultTagsAttrName: "(Optional) Freeform and defined tags applied to every resource that supports tagging.\n" +
+			"Tags set on a resource override the default tags with the same key.",
+		oboTokenAttrName: "(Optional) A token to make requests on behalf of another user, for example a user in a child tenancy.\n" +
+			"Requests are signed with the configured credentials and include the token.",
+		regionRealmsAttrName: "(Optional) A map of region name to the realm (e.g. oc2) of regions that are not known to the provider yet, such as dedicated regions.",
+		realmDomainsAttrName: "(Optional) A map of realm to the second level domain of its endpoints (e.g. oraclegovcloud.com), for realms that are not known to the provider yet.",
+		ignoreDefinedTagsAttrName: "(Optional) A list of defined tag namespaces or tag keys in the form {namespace}.{key} that are applied outside of Terraform.\n" +
+			"Differences in these defined tags are not shown as changes for any resource.",
 		configFileProfileAttrName: "(Optional) The profile name to be used from config file, if not set it will be DEFAULT.",
+		customEndpointsAttrName: "(Optional) A map of service name (e.g. core, kms, objectstorage) to the endpoint URL to use for that service\n" +
+			"instead of the one resolved from the region. The kms endpoint is only used for vaults, the management and crypto endpoints of\n" +
+			"each vault are set with management_endpoint and crypto_endpoint.",
+		deletionProtectionAttrName: "(Optional) Fail the deletion of resources that hold data or keys that cannot be recovered, such as vaults, keys,\n" +
+			"buckets with objects and databases. Set it to false to delete them.",
+		checkServiceLimitsAttrName: "(Optional) Check that the service limits and quotas of the compartment allow the instances, volumes and load balancers\n" +
+			"planned to be created. Either `warn` to log a warning, or `error` to fail the plan, when they do not. Warnings are only written to the Terraform log.",
+		pollIntervalAttrName: "(Optional) The longest duration (in seconds) between requests that check whether a resource has reached a lifecycle state.\n" +
+			"Checks back off exponentially up to this duration. Checks back off up to 10 seconds if this is not set.",
 	}
 }
 
@@ -149,7 +229,7 @@ func schemaMap() map[string]*schema.Schema {
 			Optional:     true,
 			Description:  descriptions[authAttrName],
 			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(authAttrName), ociVarName(authAttrName)}, authAPIKeySetting),
-			ValidateFunc: validation.StringInSlice([]string{authAPIKeySetting, authInstancePrincipalSetting, authInstancePrincipalWithCertsSetting}, true),
+			ValidateFunc: validation.StringInSlice([]string{authAPIKeySetting, authInstancePrincipalSetting, authInstancePrincipalWithCertsSetting, authResourcePrincipalSetting, authSecurityTokenSetting, authTokenExchangeSetting}, true),
 		},
 		tenancyOcidAttrName: {
 			Type:        schema.TypeString,
@@ -173,7 +253,6 @@ func schemaMap() map[string]*schema.Schema {
 		privateKeyAttrName: {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Default:     "",
 			Sensitive:   true,
 			Description: descriptions[privateKeyAttrName],
 			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(privateKeyAttrName), ociVarName(privateKeyAttrName)}, nil),
@@ -188,7 +267,6 @@ func schemaMap() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Optional:    true,
 			Sensitive:   true,
-			Default:     "",
 			Description: descriptions[privateKeyPasswordAttrName],
 			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(privateKeyPasswordAttrName), ociVarName(privateKeyPasswordAttrName)}, nil),
 		},
@@ -211,12 +289,121 @@ func schemaMap() map[string]*schema.Schema {
 			Description: descriptions[retryDurationSecondsAttrName],
 			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(retryDurationSecondsAttrName), ociVarName(retryDurationSecondsAttrName)}, nil),
 		},
+		maxRetriesAttrName: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions[maxRetriesAttrName],
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(maxRetriesAttrName), ociVarName(maxRetriesAttrName)}, nil),
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		retryableStatusCodesAttrName: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: descriptions[retryableStatusCodesAttrName],
+			Elem: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(400, 599),
+			},
+		},
+		retryOverridesAttrName: {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: descriptions[retryOverridesAttrName],
+			Elem:        schema.TypeInt,
+		},
+		consistencyWindowAttrName: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions[consistencyWindowAttrName],
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(consistencyWindowAttrName), ociVarName(consistencyWindowAttrName)}, nil),
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		maxRequestsPerSecondAttrName: {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Description:  descriptions[maxRequestsPerSecondAttrName],
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(maxRequestsPerSecondAttrName), ociVarName(maxRequestsPerSecondAttrName)}, nil),
+			ValidateFunc: validation.FloatBetween(0, math.MaxFloat64),
+		},
+		defaultTagsAttrName: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: descriptions[defaultTagsAttrName],
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"defined_tags": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     schema.TypeString,
+					},
+					"freeform_tags": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     schema.TypeString,
+					},
+				},
+			},
+		},
+		oboTokenAttrName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: descriptions[oboTokenAttrName],
+		},
+		regionRealmsAttrName: {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: descriptions[regionRealmsAttrName],
+			Elem:        schema.TypeString,
+		},
+		realmDomainsAttrName: {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: descriptions[realmDomainsAttrName],
+			Elem:        schema.TypeString,
+		},
+		ignoreDefinedTagsAttrName: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: descriptions[ignoreDefinedTagsAttrName],
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
 		configFileProfileAttrName: {
 			Type:        schema.TypeString,
 			Optional:    true,
 			Description: descriptions[configFileProfileAttrName],
 			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(configFileProfileAttrName), ociVarName(configFileProfileAttrName)}, nil),
 		},
+		customEndpointsAttrName: {
+			Type:         schema.TypeMap,
+			Optional:     true,
+			Description:  descriptions[customEndpointsAttrName],
+			Elem:         schema.TypeString,
+			ValidateFunc: validateCustomEndpoints,
+		},
+		deletionProtectionAttrName: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: descriptions[deletionProtectionAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(deletionProtectionAttrName), ociVarName(deletionProtectionAttrName)}, false),
+		},
+		checkServiceLimitsAttrName: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  descriptions[checkServiceLimitsAttrName],
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(checkServiceLimitsAttrName), ociVarName(checkServiceLimitsAttrName)}, nil),
+			ValidateFunc: validation.StringInSlice([]string{serviceLimitsCheckWarn, serviceLimitsCheckError}, false),
+		},
+		pollIntervalAttrName: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions[pollIntervalAttrName],
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(pollIntervalAttrName), ociVarName(pollIntervalAttrName)}, nil),
+			ValidateFunc: validation.IntAtLeast(1),
+		},
 	}
 }
 
@@ -224,6 +411,10 @@ func RegisterResource(name string, resourceSchema *schema.Resource) {
 	if OciResources == nil {
 		OciResources = make(map[string]*schema.Resource)
 	}
+	addDefaultTagsCustomizeDiff(resourceSchema)
+	addDefinedTagsCaseNormalization(resourceSchema)
+	addRegionOverride(resourceSchema, true)
+	addOcidValidation(resourceSchema)
 	OciResources[name] = resourceSchema
 }
 
@@ -231,6 +422,9 @@ func RegisterDatasource(name string, datasourceSchema *schema.Resource) {
 	if OciDatasources == nil {
 		OciDatasources = make(map[string]*schema.Resource)
 	}
+	addListSortAndLimit(datasourceSchema)
+	addRegionOverride(datasourceSchema, false)
+	addOcidValidation(datasourceSchema)
 	OciDatasources[name] = datasourceSchema
 }
 
@@ -244,6 +438,7 @@ func DataSourcesMap() map[string]*schema.Resource {
 	RegisterDatasource("oci_core_virtual_networks", CoreVcnsDataSource())
 	RegisterDatasource("oci_load_balancers", LoadBalancerLoadBalancersDataSource())
 	RegisterDatasource("oci_load_balancer_backendsets", LoadBalancerBackendSetsDataSource())
+	registerSingularDataSources()
 	return OciDatasources
 }
 
@@ -341,11 +536,104 @@ func ProviderConfig(d *schema.ResourceData) (interface{}, error) {
 		configuredRetryDuration = &val
 	}
 
+	configuredMaxRetries = nil
+	configuredRetryableStatusCodes = nil
+	configuredServiceRetryDurations = nil
+	if !d.Get(disableAutoRetriesAttrName).(bool) {
+		if retryOverrides, exists := d.GetOkExists(retryOverridesAttrName); exists {
+			serviceRetryDurations, err := getServiceRetryDurations(retryOverrides.(map[string]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			configuredServiceRetryDurations = serviceRetryDurations
+		}
+		if maxRetries, exists := d.GetOkExists(maxRetriesAttrName); exists {
+			tmp := maxRetries.(int)
+			configuredMaxRetries = &tmp
+		}
+		if statusCodes, exists := d.GetOkExists(retryableStatusCodesAttrName); exists {
+			configuredRetryableStatusCodes = map[int]bool{}
+			for _, statusCode := range statusCodes.([]interface{}) {
+				configuredRetryableStatusCodes[statusCode.(int)] = true
+			}
+		}
+	}
+
+	eventualConsistencyWindow = defaultEventualConsistencyWindow
+	if consistencyWindowSeconds, ok := d.GetOkExists(consistencyWindowAttrName); ok {
+		eventualConsistencyWindow = time.Duration(consistencyWindowSeconds.(int)) * time.Second
+	}
+
+	setRequestsPerSecond(0)
+	if requestsPerSecond, ok := d.GetOkExists(maxRequestsPerSecondAttrName); ok {
+		setRequestsPerSecond(requestsPerSecond.(float64))
+	}
+
+	defaultFreeformTags = nil
+	defaultDefinedTags = nil
+	if defaultTags, ok := d.GetOkExists(defaultTagsAttrName); ok {
+		if tmpList := defaultTags.([]interface{}); len(tmpList) > 0 && tmpList[0] != nil {
+			defaultTagsMap := tmpList[0].(map[string]interface{})
+			defaultFreeformTags, _ = defaultTagsMap["freeform_tags"].(map[string]interface{})
+			defaultDefinedTags, _ = defaultTagsMap["defined_tags"].(map[string]interface{})
+			if _, err := mapToDefinedTags(defaultDefinedTags); err != nil {
+				return nil, fmt.Errorf("invalid %s: %v", defaultTagsAttrName, err)
+			}
+		}
+	}
+
+	ignoredDefinedTags = nil
+	if ignoreDefinedTags, ok := d.GetOkExists(ignoreDefinedTagsAttrName); ok {
+		for _, tag := range ignoreDefinedTags.([]interface{}) {
+			if tag != nil {
+				ignoredDefinedTags = append(ignoredDefinedTags, tag.(string))
+			}
+		}
+	}
+
+	regionDomains = nil
+	if regionRealms, ok := d.GetOkExists(regionRealmsAttrName); ok {
+		var configuredRealmDomains map[string]string
+		if domains, ok := d.GetOkExists(realmDomainsAttrName); ok {
+			configuredRealmDomains = objectMapToStringMap(domains.(map[string]interface{}))
+		}
+		domains, err := getRegionDomains(objectMapToStringMap(regionRealms.(map[string]interface{})), configuredRealmDomains)
+		if err != nil {
+			return nil, err
+		}
+		regionDomains = domains
+	}
+
+	customEndpoints = nil
+	if endpoints, ok := d.GetOkExists(customEndpointsAttrName); ok {
+		customEndpoints = objectMapToStringMap(endpoints.(map[string]interface{}))
+	}
+
+	deletionProtection = false
+	if enabled, ok := d.GetOkExists(deletionProtectionAttrName); ok {
+		deletionProtection = enabled.(bool)
+	}
+
+	serviceLimitsCheck = ""
+	if check, ok := d.GetOkExists(checkServiceLimitsAttrName); ok {
+		serviceLimitsCheck = check.(string)
+	}
+
+	providerPollInterval = 0
+	if pollIntervalSeconds, ok := d.GetOkExists(pollIntervalAttrName); ok {
+		providerPollInterval = time.Duration(pollIntervalSeconds.(int)) * time.Second
+	}
+
 	sdkConfigProvider, err := getSdkConfigProvider(d, clients)
 	if err != nil {
 		return nil, err
 	}
 
+	configuredOboToken = ""
+	if oboToken, ok := d.GetOkExists(oboTokenAttrName); ok {
+		configuredOboToken = oboToken.(string)
+	}
+
 	httpClient := buildHttpClient()
 
 	// beware: global variable `configureClient` set here--used elsewhere outside this execution path
@@ -358,6 +646,7 @@ func ProviderConfig(d *schema.ResourceData) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	clients.sdkConfigProvider = sdkConfigProvider
 
 	avoidWaitingForDeleteTarget, _ = strconv.ParseBool(getEnvSettingWithDefault("avoid_waiting_for_delete_target", "false"))
 
@@ -369,6 +658,7 @@ func getSdkConfigProvider(d *schema.ResourceData, clients *OracleClients) (oci_c
 	auth := strings.ToLower(d.Get(authAttrName).(string))
 	profile := d.Get(configFileProfileAttrName).(string)
 	clients.configuration[authAttrName] = auth
+	configuredAuth = auth
 
 	configProviders, err := getConfigProviders(d, auth)
 	if err != nil {
@@ -488,9 +778,74 @@ func getConfigProviders(d *schema.ResourceData, auth string) ([]oci_common.Confi
 		}
 		log.Printf("[DEBUG] Configuration provided by: %s", cfg)
 
+		configProviders = append(configProviders, cfg)
+	case strings.ToLower(authResourcePrincipalSetting):
+		apiKeyConfigVariablesToUnset, ok := checkIncompatibleAttrsForApiKeyAuth(d)
+		if !ok {
+			return nil, fmt.Errorf(`user credentials %v should be removed from the configuration`, strings.Join(apiKeyConfigVariablesToUnset, ", "))
+		}
+
+		// The SDK reads the resource principal session token, private key and region from the OCI_RESOURCE_PRINCIPAL_*
+		// environment variables set by the platform. When these point to files, the token is re-read once it expires.
+		cfg, err := oci_common_auth.ResourcePrincipalConfigurationProvider()
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("[DEBUG] Configuration provided by: %s", cfg)
+
+		configProviders = append(configProviders, cfg)
+	case strings.ToLower(authSecurityTokenSetting):
+		apiKeyConfigVariablesToUnset, ok := checkIncompatibleAttrsForApiKeyAuth(d)
+		if !ok {
+			return nil, fmt.Errorf(`user credentials %v should be removed from the configuration`, strings.Join(apiKeyConfigVariablesToUnset, ", "))
+		}
+
+		profile, ok := d.GetOkExists(configFileProfileAttrName)
+		if !ok || profile.(string) == "" {
+			return nil, fmt.Errorf("can not get %s from Terraform configuration (SecurityToken)", configFileProfileAttrName)
+		}
+
+		defaultPath := path.Join(getHomeFolder(), defaultConfigDirName, defaultConfigFileName)
+		if err := checkProfile(profile.(string), defaultPath); err != nil {
+			return nil, err
+		}
+
+		securityTokenFilePath, err := getSecurityTokenFilePath(profile.(string), defaultPath)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg := securityTokenConfigProvider{
+			ConfigurationProvider: oci_common.CustomProfileConfigProvider(defaultPath, profile.(string)),
+			SecurityTokenFilePath: securityTokenFilePath,
+		}
+		log.Printf("[DEBUG] Configuration provided by: security token file %s", securityTokenFilePath)
+
+		configProviders = append(configProviders, cfg)
+	case strings.ToLower(authTokenExchangeSetting):
+		apiKeyConfigVariablesToUnset, ok := checkIncompatibleAttrsForApiKeyAuth(d)
+		if !ok {
+			return nil, fmt.Errorf(`user credentials %v should be removed from the configuration`, strings.Join(apiKeyConfigVariablesToUnset, ", "))
+		}
+
+		tenancy, ok := d.GetOkExists(tenancyOcidAttrName)
+		if !ok || tenancy.(string) == "" {
+			return nil, fmt.Errorf("can not get %s from Terraform configuration (%s)", tenancyOcidAttrName, authTokenExchangeSetting)
+		}
+		region, ok := d.GetOkExists(regionAttrName)
+		if !ok || region.(string) == "" {
+			return nil, fmt.Errorf("can not get %s from Terraform configuration (%s)", regionAttrName, authTokenExchangeSetting)
+		}
+
+		cfg, err := newTokenExchangeConfigProvider(tenancy.(string), region.(string))
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("[DEBUG] Configuration provided by: %s", cfg)
+
 		configProviders = append(configProviders, cfg)
 	default:
-		return nil, fmt.Errorf("auth must be one of '%s' or '%s' or '%s'", authAPIKeySetting, authInstancePrincipalSetting, authInstancePrincipalWithCertsSetting)
+		return nil, fmt.Errorf("auth must be one of '%s' or '%s' or '%s' or '%s' or '%s' or '%s'", authAPIKeySetting, authInstancePrincipalSetting, authInstancePrincipalWithCertsSetting, authResourcePrincipalSetting, authSecurityTokenSetting, authTokenExchangeSetting)
 	}
 
 	return configProviders, nil
@@ -511,6 +866,11 @@ func buildHttpClient() (httpClient *http.Client) {
 	return
 }
 
+// The Cloud Shell delegation token is only sent when authenticating as the instance principal of the Cloud Shell host
+func isInstancePrincipalAuth(auth string) bool {
+	return auth == strings.ToLower(authInstancePrincipalSetting) || auth == strings.ToLower(authInstancePrincipalWithCertsSetting)
+}
+
 func buildConfigureClientFn(configProvider oci_common.ConfigurationProvider, httpClient *http.Client) (ConfigureClient, error) {
 
 	if ociProvider != nil && len(ociProvider.TerraformVersion) > 0 {
@@ -529,7 +889,19 @@ func buildConfigureClientFn(configProvider oci_common.ConfigurationProvider, htt
 	requestSigner := oci_common.DefaultRequestSigner(configProvider)
 	var oboTokenProvider OboTokenProvider
 	oboTokenProvider = emptyOboTokenProvider{}
-	if useOboToken {
+	// An explicit obo_token takes precedence over the Cloud Shell delegation token and the use_obo_token environment variable
+	if configuredOboToken != "" {
+		// Requests are made on behalf of the user the token was issued to, which may be in a different tenancy
+		httpHeadersToSign := append(oci_common.DefaultGenericHeaders(), requestHeaderOpcOboToken)
+		requestSigner = oci_common.RequestSigner(configProvider, httpHeadersToSign, oci_common.DefaultBodyHeaders())
+		oboTokenProvider = oboTokenProviderFromValue{token: configuredOboToken}
+	} else if delegationTokenFile := os.Getenv(delegationTokenFileEnv); delegationTokenFile != "" && isInstancePrincipalAuth(configuredAuth) {
+		// Running inside Cloud Shell, requests are signed on behalf of the console user with the delegation token
+		log.Printf("[DEBUG] Using delegation token from: %s", delegationTokenFile)
+		httpHeadersToSign := append(oci_common.DefaultGenericHeaders(), requestHeaderOpcOboToken)
+		requestSigner = oci_common.RequestSigner(configProvider, httpHeadersToSign, oci_common.DefaultBodyHeaders())
+		oboTokenProvider = oboTokenProviderFromFile{path: delegationTokenFile}
+	} else if useOboToken {
 		// Add Obo token to the default list and update the signer
 		httpHeadersToSign := append(oci_common.DefaultGenericHeaders(), requestHeaderOpcOboToken)
 		requestSigner = oci_common.RequestSigner(configProvider, httpHeadersToSign, oci_common.DefaultBodyHeaders())
@@ -560,6 +932,10 @@ func buildConfigureClientFn(configProvider oci_common.ConfigurationProvider, htt
 			return nil
 		}
 
+		if host, ok := getRegionDomainHost(client.Host); ok {
+			client.Host = host
+		}
+
 		domainNameOverride := getEnvSettingWithBlankDefault(domainNameOverrideEnv)
 
 		if domainNameOverride != "" {
@@ -567,6 +943,10 @@ func buildConfigureClientFn(configProvider oci_common.ConfigurationProvider, htt
 			client.Host = re.ReplaceAllString(client.Host, "${1}"+domainNameOverride) // non-match conveniently returns original string
 		}
 
+		if endpoint, ok := getCustomEndpoint(client.Host); ok {
+			client.Host = endpoint
+		}
+
 		customCertLoc := getEnvSettingWithBlankDefault(customCertLocationEnv)
 
 		if customCertLoc != "" {
@@ -574,7 +954,11 @@ func buildConfigureClientFn(configProvider oci_common.ConfigurationProvider, htt
 			if err != nil {
 				return err
 			}
-			pool := x509.NewCertPool()
+			// Keep trusting the system roots, so endpoints that bypass an intercepting proxy still verify
+			pool, err := x509.SystemCertPool()
+			if err != nil || pool == nil {
+				pool = x509.NewCertPool()
+			}
 			if ok := pool.AppendCertsFromPEM(cert); !ok {
 				return fmt.Errorf("failed to append custom cert to the pool")
 			}
@@ -596,6 +980,14 @@ func buildConfigureClientFn(configProvider oci_common.ConfigurationProvider, htt
 			}
 		}
 
+		// Requests are logged at the DEBUG level, so they only show up with TF_LOG=DEBUG or TRACE
+		client.HTTPClient = loggingDispatcher{dispatcher: client.HTTPClient}
+
+		// The limiter wraps the shared client per SDK client, so requests are throttled for every operation and data source
+		if configuredRequestsPerSecond > 0 {
+			client.HTTPClient = rateLimitedDispatcher{dispatcher: client.HTTPClient}
+		}
+
 		return nil
 	}
 
@@ -693,8 +1085,9 @@ func (p ResourceDataConfigProvider) PrivateRSAKey() (key *rsa.PrivateKey, err er
 		password = privateKeyPassword.(string)
 	}
 
-	if privateKey, hasPrivateKey := p.D.GetOkExists(privateKeyAttrName); hasPrivateKey {
-		return oci_common.PrivateKeyFromBytes([]byte(privateKey.(string)), &password)
+	// The key content is only held in memory, so keys injected through environment variables never have to be written to disk
+	if privateKey, hasPrivateKey := p.D.GetOkExists(privateKeyAttrName); hasPrivateKey && privateKey.(string) != "" {
+		return oci_common.PrivateKeyFromBytes([]byte(normalizePrivateKeyPem(privateKey.(string))), &password)
 	}
 
 	if privateKeyPath, hasPrivateKeyPath := p.D.GetOkExists(privateKeyPathAttrName); hasPrivateKeyPath {
@@ -707,3 +1100,182 @@ func (p ResourceDataConfigProvider) PrivateRSAKey() (key *rsa.PrivateKey, err er
 
 	return nil, fmt.Errorf("can not get private_key or private_key_path from Terraform configuration")
 }
+
+// normalizePrivateKeyPem restores the line breaks of a PEM key that was passed on a single line with escaped newlines,
+// which is how most CI systems store multi-line secrets in environment variables
+func normalizePrivateKeyPem(privateKey string) string {
+	if !strings.Contains(privateKey, "\n") && strings.Contains(privateKey, `\n`) {
+		return strings.Replace(privateKey, `\n`, "\n", -1)
+	}
+	return privateKey
+}
+
+// securityTokenConfigProvider signs requests with the session token created by `oci session authenticate` for a
+// config file profile. The token file is re-read for every request, so a token refreshed with `oci session refresh`
+// during a long apply is picked up without restarting Terraform.
+type securityTokenConfigProvider struct {
+	oci_common.ConfigurationProvider
+	SecurityTokenFilePath string
+}
+
+// Session profiles are not tied to a user or an API key, so these are not required
+func (p securityTokenConfigProvider) UserOCID() (string, error) {
+	return "", nil
+}
+
+func (p securityTokenConfigProvider) KeyFingerprint() (string, error) {
+	return "", nil
+}
+
+func (p securityTokenConfigProvider) KeyID() (string, error) {
+	token, err := ioutil.ReadFile(p.SecurityTokenFilePath)
+	if err != nil {
+		return "", fmt.Errorf("can not read security token from: '%s', Error: %q", p.SecurityTokenFilePath, err)
+	}
+	return fmt.Sprintf("ST$%s", strings.TrimSpace(string(token))), nil
+}
+
+func getSecurityTokenFilePath(profile string, path string) (string, error) {
+	var profileRegex = regexp.MustCompile(`^\[(.*)\]`)
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	inProfile := false
+	for _, line := range strings.Split(string(data), "\n") {
+		if match := profileRegex.FindStringSubmatch(line); match != nil && len(match) > 1 {
+			inProfile = match[1] == profile
+			continue
+		}
+		if !inProfile {
+			continue
+		}
+		splits := strings.SplitN(line, "=", 2)
+		if len(splits) == 2 && strings.EqualFold(strings.TrimSpace(splits[0]), "security_token_file") {
+			securityTokenFilePath := strings.TrimSpace(splits[1])
+			if strings.HasPrefix(securityTokenFilePath, "~") {
+				securityTokenFilePath = filepath.Join(getHomeFolder(), securityTokenFilePath[1:])
+			}
+			return securityTokenFilePath, nil
+		}
+	}
+
+	return "", fmt.Errorf("configuration file profile %s did not contain security_token_file", profile)
+}
+
+// Maps the service names accepted in custom_endpoints to the first label of the host the SDK resolves for that service.
+// Services that share an endpoint (e.g. core, load balancer and work requests) share the override.
+var customEndpointServiceHosts = map[string]string{
+	"analytics":       "analytics",
+	"apigateway":      "apigateway",
+	"audit":           "audit",
+	"autoscaling":     "autoscaling",
+	"bds":             "bigdataservice",
+	"budget":          "usage",
+	"containerengine": "containerengine",
+	"core":            "iaas",
+	"database":        "database",
+	"datacatalog":     "datacatalog",
+	"dataflow":        "dataflow",
+	"datasafe":        "datasafe",
+	"datascience":     "datascience",
+	"dns":             "dns",
+	"email":           "email",
+	"events":          "events",
+	"file_storage":    "filestorage",
+	"functions":       "functions",
+	"health_checks":   "healthchecks",
+	"identity":        "identity",
+	"integration":     "integration",
+	"kms":             "kms",
+	"limits":          "limits",
+	"marketplace":     "marketplace",
+	"monitoring":      "telemetry",
+	"nosql":           "nosql",
+	"objectstorage":   "objectstorage",
+	"oce":             "cp",
+	"oda":             "digitalassistant-api",
+	"ons":             "notification",
+	"osmanagement":    "osms",
+	"resourcemanager": "resourcemanager",
+	"streaming":       "streaming",
+	"vault":           "vaults",
+	"waas":            "waas",
+}
+
+func validateCustomEndpoints(v interface{}, k string) (ws []string, errors []error) {
+	for service, endpoint := range v.(map[string]interface{}) {
+		if _, ok := customEndpointServiceHosts[service]; !ok {
+			errors = append(errors, fmt.Errorf("%s: unsupported service %q", k, service))
+			continue
+		}
+		if u, err := url.Parse(endpoint.(string)); err != nil || u.Scheme == "" || u.Host == "" {
+			errors = append(errors, fmt.Errorf("%s: endpoint for service %q must be a URL such as https://iaas.example.com, got %q", k, service, endpoint))
+		}
+	}
+	return
+}
+
+func getCustomEndpoint(host string) (string, bool) {
+	if len(customEndpoints) == 0 {
+		return "", false
+	}
+	u, err := url.Parse(host)
+	if err != nil || u.Hostname() == "" {
+		return "", false
+	}
+	hostLabel := strings.SplitN(u.Hostname(), ".", 2)[0]
+	for service, endpoint := range customEndpoints {
+		if customEndpointServiceHosts[service] == hostLabel {
+			return endpoint, true
+		}
+	}
+	return "", false
+}
+
+// getRegionDomains resolves the second level domain of each region in region_realms, using the realms in
+// realm_domains in addition to the realms known to the provider
+func getRegionDomains(regionRealms map[string]string, configuredRealmDomains map[string]string) (map[string]string, error) {
+	result := map[string]string{}
+	for region, realm := range regionRealms {
+		realm = strings.ToLower(realm)
+		domain, ok := configuredRealmDomains[realm]
+		if !ok {
+			domain, ok = realmDomains[realm]
+		}
+		if !ok {
+			return nil, fmt.Errorf("%s: unknown realm %q for region %q, add its domain to %s", regionRealmsAttrName, realm, region, realmDomainsAttrName)
+		}
+		result[strings.ToLower(region)] = domain
+	}
+	return result, nil
+}
+
+// getRegionDomainHost replaces the second level domain of endpoints in the regions of region_realms, which the SDK
+// would otherwise resolve to the commercial realm. For example: https://iaas.my-region-1.oraclecloud.com => https://iaas.my-region-1.example.com
+func getRegionDomainHost(host string) (string, bool) {
+	for region, domain := range regionDomains {
+		if index := strings.Index(host, "."+region+"."); index >= 0 {
+			return host[:index] + "." + region + "." + domain, true
+		}
+	}
+	return "", false
+}
+
+// getServiceRetryDurations converts the retry_overrides in seconds to retry durations, a negative value retries for
+// the maximum amount of time as it does for retry_duration_seconds
+func getServiceRetryDurations(retryOverrides map[string]interface{}) (map[string]time.Duration, error) {
+	result := map[string]time.Duration{}
+	for service, value := range retryOverrides {
+		seconds, err := strconv.Atoi(fmt.Sprintf("%v", value))
+		if err != nil {
+			return nil, fmt.Errorf("%s: retry duration for service %q must be a number of seconds, got %q", retryOverridesAttrName, service, value)
+		}
+		duration := time.Duration(seconds) * time.Second
+		if seconds < 0 {
+			duration = time.Duration(math.MaxInt64)
+		}
+		result[strings.ToLower(service)] = duration
+	}
+	return result, nil
+}
@@ -3,6 +3,9 @@
 package oci
 
 import (
+	"fmt"
+	"sync"
+
 	oci_analytics "github.com/oracle/oci-go-sdk/analytics"
 	oci_apigateway "github.com/oracle/oci-go-sdk/apigateway"
 	oci_audit "github.com/oracle/oci-go-sdk/audit"
@@ -46,6 +49,13 @@ import (
 
 type OracleClients struct {
 	configuration                  map[string]string
+	sdkConfigProvider              oci_common.ConfigurationProvider
+	regionClients                  map[string]*OracleClients
+	regionClientsLock              sync.Mutex
+	endpointClientsLock            sync.Mutex
+	functionsInvokeClients         map[string]*oci_functions.FunctionsInvokeClient
+	kmsCryptoClients               map[string]*oci_kms.KmsCryptoClient
+	kmsManagementClients           map[string]*oci_kms.KmsManagementClient
 	analyticsClient                *oci_analytics.AnalyticsClient
 	auditClient                    *oci_audit.AuditClient
 	autoScalingClient              *oci_auto_scaling.AutoScalingClient
@@ -96,11 +106,25 @@ type OracleClients struct {
 	workRequestClient              *oci_work_requests.WorkRequestClient
 }
 
+// Clients for service endpoints that are specific to a resource, such as a vault or a function, are created the first
+// time an endpoint is used and shared afterwards, so that the signer and HTTP client are not rebuilt for every operation.
+
 func (m *OracleClients) FunctionsInvokeClient(endpoint string) (*oci_functions.FunctionsInvokeClient, error) {
+	m.endpointClientsLock.Lock()
+	defer m.endpointClientsLock.Unlock()
+
+	if client, ok := m.functionsInvokeClients[endpoint]; ok {
+		return client, nil
+	}
+
 	if client, err := oci_functions.NewFunctionsInvokeClientWithConfigurationProvider(*m.functionsInvokeClient.ConfigurationProvider(), endpoint); err == nil {
 		if err = configureClient(&client.BaseClient); err != nil {
 			return nil, err
 		}
+		if m.functionsInvokeClients == nil {
+			m.functionsInvokeClients = map[string]*oci_functions.FunctionsInvokeClient{}
+		}
+		m.functionsInvokeClients[endpoint] = &client
 		return &client, nil
 	} else {
 		return nil, err
@@ -108,10 +132,21 @@ func (m *OracleClients) FunctionsInvokeClient(endpoint string) (*oci_functions.F
 }
 
 func (m *OracleClients) KmsCryptoClient(endpoint string) (*oci_kms.KmsCryptoClient, error) {
+	m.endpointClientsLock.Lock()
+	defer m.endpointClientsLock.Unlock()
+
+	if client, ok := m.kmsCryptoClients[endpoint]; ok {
+		return client, nil
+	}
+
 	if client, err := oci_kms.NewKmsCryptoClientWithConfigurationProvider(*m.kmsCryptoClient.ConfigurationProvider(), endpoint); err == nil {
 		if err = configureClient(&client.BaseClient); err != nil {
 			return nil, err
 		}
+		if m.kmsCryptoClients == nil {
+			m.kmsCryptoClients = map[string]*oci_kms.KmsCryptoClient{}
+		}
+		m.kmsCryptoClients[endpoint] = &client
 		return &client, nil
 	} else {
 		return nil, err
@@ -119,16 +154,72 @@ func (m *OracleClients) KmsCryptoClient(endpoint string) (*oci_kms.KmsCryptoClie
 }
 
 func (m *OracleClients) KmsManagementClient(endpoint string) (*oci_kms.KmsManagementClient, error) {
+	m.endpointClientsLock.Lock()
+	defer m.endpointClientsLock.Unlock()
+
+	if client, ok := m.kmsManagementClients[endpoint]; ok {
+		return client, nil
+	}
+
 	if client, err := oci_kms.NewKmsManagementClientWithConfigurationProvider(*m.kmsManagementClient.ConfigurationProvider(), endpoint); err == nil {
 		if err = configureClient(&client.BaseClient); err != nil {
 			return nil, err
 		}
+		if m.kmsManagementClients == nil {
+			m.kmsManagementClients = map[string]*oci_kms.KmsManagementClient{}
+		}
+		m.kmsManagementClients[endpoint] = &client
 		return &client, nil
 	} else {
 		return nil, err
 	}
 }
 
+// regionConfigurationProvider uses the credentials of the provider configuration for a different region
+type regionConfigurationProvider struct {
+	oci_common.ConfigurationProvider
+	region string
+}
+
+func (p regionConfigurationProvider) Region() (string, error) {
+	return p.region, nil
+}
+
+// ForRegion returns the clients to use for resources and data sources that override the provider region.
+// Clients for each region are created the first time they are needed and reused afterwards.
+func (m *OracleClients) ForRegion(region string) (*OracleClients, error) {
+	if region == "" || m.sdkConfigProvider == nil {
+		return m, nil
+	}
+	if providerRegion, err := m.sdkConfigProvider.Region(); err == nil && providerRegion == region {
+		return m, nil
+	}
+
+	m.regionClientsLock.Lock()
+	defer m.regionClientsLock.Unlock()
+
+	if clients, ok := m.regionClients[region]; ok {
+		return clients, nil
+	}
+
+	clients := &OracleClients{configuration: map[string]string{}}
+	for key, value := range m.configuration {
+		clients.configuration[key] = value
+	}
+	clients.configuration["region"] = region
+	clients.sdkConfigProvider = regionConfigurationProvider{ConfigurationProvider: m.sdkConfigProvider, region: region}
+
+	if err := createSDKClients(clients, clients.sdkConfigProvider, configureClient); err != nil {
+		return nil, fmt.Errorf("cannot create clients for region %s: %v", region, err)
+	}
+
+	if m.regionClients == nil {
+		m.regionClients = map[string]*OracleClients{}
+	}
+	m.regionClients[region] = clients
+	return clients, nil
+}
+
 func createSDKClients(clients *OracleClients, configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient) (err error) {
 
 	analyticsClient, err := oci_analytics.NewAnalyticsClientWithConfigurationProvider(configProvider)
@@ -18,6 +18,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
@@ -440,8 +441,18 @@ func providerConfigTest(t *testing.T, disableRetries bool, skipRequiredField boo
 		assert.False(t, ok)
 		assert.Equal(t, fmt.Sprintf("user credentials %v should be removed from the configuration", strings.Join(apiKeyConfigVariablesToUnset, ", ")), err.Error())
 		return
+	case authResourcePrincipalSetting:
+		apiKeyConfigVariablesToUnset, ok := checkIncompatibleAttrsForApiKeyAuth(d)
+		assert.False(t, ok)
+		assert.Equal(t, fmt.Sprintf("user credentials %v should be removed from the configuration", strings.Join(apiKeyConfigVariablesToUnset, ", ")), err.Error())
+		return
+	case authSecurityTokenSetting:
+		apiKeyConfigVariablesToUnset, ok := checkIncompatibleAttrsForApiKeyAuth(d)
+		assert.False(t, ok)
+		assert.Equal(t, fmt.Sprintf("user credentials %v should be removed from the configuration", strings.Join(apiKeyConfigVariablesToUnset, ", ")), err.Error())
+		return
 	default:
-		assert.Error(t, err, fmt.Sprintf("auth must be one of '%s' or '%s' or '%s'", authAPIKeySetting, authInstancePrincipalSetting, authInstancePrincipalWithCertsSetting))
+		assert.Error(t, err, fmt.Sprintf("auth must be one of '%s' or '%s' or '%s' or '%s' or '%s' or '%s'", authAPIKeySetting, authInstancePrincipalSetting, authInstancePrincipalWithCertsSetting, authResourcePrincipalSetting, authSecurityTokenSetting, authTokenExchangeSetting))
 		return
 	}
 	assert.Nil(t, err)
@@ -509,6 +520,8 @@ func TestUnitProviderConfig(t *testing.T) {
 	providerConfigTest(t, true, true, authAPIKeySetting, "", nil)              // ApiKey with required fields + disable auto-retries
 	providerConfigTest(t, false, true, authAPIKeySetting, "", nil)             // ApiKey without required fields
 	providerConfigTest(t, false, false, authInstancePrincipalSetting, "", nil) // InstancePrincipal
+	providerConfigTest(t, false, false, authResourcePrincipalSetting, "", nil) // ResourcePrincipal
+	providerConfigTest(t, false, false, authSecurityTokenSetting, "", nil)     // SecurityToken
 	providerConfigTest(t, true, false, "invalid-auth-setting", "", nil)        // Invalid auth + disable auto-retries
 	configFile, keyFile, err := writeConfigFile()
 	assert.Nil(t, err)
@@ -593,6 +606,189 @@ func TestUnitBuildClientConfigureFn_withCustomCert(t *testing.T) {
 	assert.NotNil(t, tr.TLSClientConfig.RootCAs)
 }
 
+// ensure the Cloud Shell delegation token is sent, and re-read, on every request
+func TestUnitBuildClientConfigureFn_delegationToken(t *testing.T) {
+	tempToken, err := ioutil.TempFile("", "delegation_token")
+	if err != nil {
+		t.Error(err)
+	}
+	defer os.Remove(tempToken.Name())
+
+	if _, err := tempToken.Write([]byte("token1\n")); err != nil {
+		t.Error(err)
+	}
+	if err := tempToken.Close(); err != nil {
+		t.Error(err)
+	}
+
+	prevEnvVar, hadPreviousEnvVar := os.LookupEnv(delegationTokenFileEnv)
+	if hadPreviousEnvVar {
+		defer os.Setenv(delegationTokenFileEnv, prevEnvVar)
+	} else {
+		defer os.Unsetenv(delegationTokenFileEnv)
+	}
+
+	os.Setenv(delegationTokenFileEnv, tempToken.Name())
+	configuredAuth = strings.ToLower(authInstancePrincipalWithCertsSetting)
+	defer func() { configuredAuth = "" }()
+
+	configProvider := oci_common.DefaultConfigProvider()
+	httpClient := buildHttpClient()
+	configureClientFn, err := buildConfigureClientFn(configProvider, httpClient)
+	assert.NoError(t, err)
+
+	baseClient := &oci_common.BaseClient{}
+	err = configureClientFn(baseClient)
+	assert.NoError(t, err)
+
+	request, _ := http.NewRequest(http.MethodGet, "https://www.oracle.com", nil)
+	assert.NoError(t, baseClient.Interceptor(request))
+	assert.Equal(t, "token1", request.Header.Get(requestHeaderOpcOboToken))
+
+	assert.NoError(t, ioutil.WriteFile(tempToken.Name(), []byte("token2"), 0600))
+	request, _ = http.NewRequest(http.MethodGet, "https://www.oracle.com", nil)
+	assert.NoError(t, baseClient.Interceptor(request))
+	assert.Equal(t, "token2", request.Header.Get(requestHeaderOpcOboToken))
+
+	// the delegation token is ignored when the provider does not authenticate as an instance principal
+	configuredAuth = strings.ToLower(authAPIKeySetting)
+	configureClientFn, err = buildConfigureClientFn(configProvider, httpClient)
+	assert.NoError(t, err)
+
+	baseClient = &oci_common.BaseClient{}
+	assert.NoError(t, configureClientFn(baseClient))
+	request, _ = http.NewRequest(http.MethodGet, "https://www.oracle.com", nil)
+	assert.NoError(t, baseClient.Interceptor(request))
+	assert.Empty(t, request.Header.Get(requestHeaderOpcOboToken))
+}
+
+// ensure the obo token from the provider block is sent with every request
+func TestUnitBuildClientConfigureFn_oboToken(t *testing.T) {
+	configuredOboToken = "token1"
+	defer func() { configuredOboToken = "" }()
+
+	configProvider := oci_common.DefaultConfigProvider()
+	httpClient := buildHttpClient()
+	configureClientFn, err := buildConfigureClientFn(configProvider, httpClient)
+	assert.NoError(t, err)
+
+	baseClient := &oci_common.BaseClient{}
+	err = configureClientFn(baseClient)
+	assert.NoError(t, err)
+
+	request, _ := http.NewRequest(http.MethodGet, "https://www.oracle.com", nil)
+	assert.NoError(t, baseClient.Interceptor(request))
+	assert.Equal(t, "token1", request.Header.Get(requestHeaderOpcOboToken))
+}
+
+// ensure an explicit obo token takes precedence over the Cloud Shell delegation token
+func TestUnitBuildClientConfigureFn_oboTokenPrecedence(t *testing.T) {
+	tempToken, err := ioutil.TempFile("", "delegation_token")
+	if err != nil {
+		t.Error(err)
+	}
+	defer os.Remove(tempToken.Name())
+
+	if _, err := tempToken.Write([]byte("delegation_token")); err != nil {
+		t.Error(err)
+	}
+	if err := tempToken.Close(); err != nil {
+		t.Error(err)
+	}
+
+	prevEnvVar, hadPreviousEnvVar := os.LookupEnv(delegationTokenFileEnv)
+	if hadPreviousEnvVar {
+		defer os.Setenv(delegationTokenFileEnv, prevEnvVar)
+	} else {
+		defer os.Unsetenv(delegationTokenFileEnv)
+	}
+
+	os.Setenv(delegationTokenFileEnv, tempToken.Name())
+	configuredAuth = strings.ToLower(authInstancePrincipalWithCertsSetting)
+	configuredOboToken = "obo_token"
+	defer func() {
+		configuredAuth = ""
+		configuredOboToken = ""
+	}()
+
+	configProvider := oci_common.DefaultConfigProvider()
+	httpClient := buildHttpClient()
+	configureClientFn, err := buildConfigureClientFn(configProvider, httpClient)
+	assert.NoError(t, err)
+
+	baseClient := &oci_common.BaseClient{}
+	assert.NoError(t, configureClientFn(baseClient))
+
+	request, _ := http.NewRequest(http.MethodGet, "https://www.oracle.com", nil)
+	assert.NoError(t, baseClient.Interceptor(request))
+	assert.Equal(t, "obo_token", request.Header.Get(requestHeaderOpcOboToken))
+}
+
+// ensure custom endpoints replace the region based endpoint of matching services only
+func TestUnitBuildClientConfigureFn_customEndpoints(t *testing.T) {
+	prevCustomEndpoints := customEndpoints
+	defer func() { customEndpoints = prevCustomEndpoints }()
+
+	customEndpoints = map[string]string{
+		"core":          "https://iaas.example.com",
+		"objectstorage": "https://objectstorage.example.com",
+		"kms":           "https://kms.example.com",
+	}
+	configProvider := oci_common.DefaultConfigProvider()
+	httpClient := buildHttpClient()
+	configureClientFn, err := buildConfigureClientFn(configProvider, httpClient)
+	assert.NoError(t, err)
+
+	coreClient := &oci_common.BaseClient{Host: "https://iaas.us-phoenix-1.oraclecloud.com"}
+	assert.NoError(t, configureClientFn(coreClient))
+	assert.Equal(t, "https://iaas.example.com", coreClient.Host)
+
+	identityClient := &oci_common.BaseClient{Host: "https://identity.us-phoenix-1.oraclecloud.com"}
+	assert.NoError(t, configureClientFn(identityClient))
+	assert.Equal(t, "https://identity.us-phoenix-1.oraclecloud.com", identityClient.Host)
+
+	// The kms override only applies to the vault endpoint, and not to the management and crypto endpoints of each vault
+	kmsVaultClient := &oci_common.BaseClient{Host: "https://kms.us-phoenix-1.oraclecloud.com"}
+	assert.NoError(t, configureClientFn(kmsVaultClient))
+	assert.Equal(t, "https://kms.example.com", kmsVaultClient.Host)
+
+	kmsManagementClient := &oci_common.BaseClient{Host: "https://aaaaaaaa-management.kms.us-phoenix-1.oraclecloud.com"}
+	assert.NoError(t, configureClientFn(kmsManagementClient))
+	assert.Equal(t, "https://aaaaaaaa-management.kms.us-phoenix-1.oraclecloud.com", kmsManagementClient.Host)
+
+	_, errs := validateCustomEndpoints(map[string]interface{}{"core": "https://iaas.example.com"}, customEndpointsAttrName)
+	assert.Empty(t, errs)
+	_, errs = validateCustomEndpoints(map[string]interface{}{"unknown": "https://iaas.example.com", "kms": "kms.example.com"}, customEndpointsAttrName)
+	assert.Len(t, errs, 2)
+}
+
+// ensure endpoints of regions in other realms use the domain of their realm
+func TestUnitBuildClientConfigureFn_regionRealms(t *testing.T) {
+	prevRegionDomains := regionDomains
+	defer func() { regionDomains = prevRegionDomains }()
+
+	domains, err := getRegionDomains(map[string]string{"us-gov-dedicated-1": "OC3", "xx-newrealm-1": "oc9"}, map[string]string{"oc9": "example-cloud.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"us-gov-dedicated-1": "oraclegovcloud.com", "xx-newrealm-1": "example-cloud.com"}, domains)
+
+	_, err = getRegionDomains(map[string]string{"xx-newrealm-1": "oc9"}, nil)
+	assert.Error(t, err)
+
+	regionDomains = domains
+	configProvider := oci_common.DefaultConfigProvider()
+	httpClient := buildHttpClient()
+	configureClientFn, err := buildConfigureClientFn(configProvider, httpClient)
+	assert.NoError(t, err)
+
+	newRealmClient := &oci_common.BaseClient{Host: "https://iaas.xx-newrealm-1.oraclecloud.com"}
+	assert.NoError(t, configureClientFn(newRealmClient))
+	assert.Equal(t, "https://iaas.xx-newrealm-1.example-cloud.com", newRealmClient.Host)
+
+	commercialClient := &oci_common.BaseClient{Host: "https://iaas.us-phoenix-1.oraclecloud.com"}
+	assert.NoError(t, configureClientFn(commercialClient))
+	assert.Equal(t, "https://iaas.us-phoenix-1.oraclecloud.com", commercialClient.Host)
+}
+
 // ensure local certs can be admitted
 func TestUnitBuildClientConfigureFn_acceptLocalCerts(t *testing.T) {
 	prevEnvVar, hadPreviousEnvVar := os.LookupEnv(acceptLocalCerts)
@@ -951,3 +1147,285 @@ func CheckResourceSetContainsElementWithPropertiesContainingNestedSets(name, set
 	}
 
 }
+
+func TestUnitSecurityTokenConfigProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "security_token")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	tokenPath := path.Join(dir, "token")
+	configPath := path.Join(dir, "config")
+	assert.Nil(t, ioutil.WriteFile(tokenPath, []byte("token1\n"), 0600))
+	config := fmt.Sprintf("[DEFAULT]\nsecurity_token_file=%s\n[SESSION]\nregion=us-phoenix-1\nsecurity_token_file = %s\n", path.Join(dir, "other"), tokenPath)
+	assert.Nil(t, ioutil.WriteFile(configPath, []byte(config), 0600))
+
+	securityTokenFilePath, err := getSecurityTokenFilePath("SESSION", configPath)
+	assert.Nil(t, err)
+	assert.Equal(t, tokenPath, securityTokenFilePath)
+
+	_, err = getSecurityTokenFilePath("MISSING", configPath)
+	assert.Error(t, err)
+
+	provider := securityTokenConfigProvider{
+		ConfigurationProvider: oci_common.CustomProfileConfigProvider(configPath, "SESSION"),
+		SecurityTokenFilePath: securityTokenFilePath,
+	}
+	keyId, err := provider.KeyID()
+	assert.Nil(t, err)
+	assert.Equal(t, "ST$token1", keyId)
+
+	// A refreshed token is used for subsequent requests
+	assert.Nil(t, ioutil.WriteFile(tokenPath, []byte("token2"), 0600))
+	keyId, err = provider.KeyID()
+	assert.Nil(t, err)
+	assert.Equal(t, "ST$token2", keyId)
+
+	userOcid, err := provider.UserOCID()
+	assert.Nil(t, err)
+	assert.Equal(t, "", userOcid)
+}
+
+func TestUnitOracleClientsForRegion(t *testing.T) {
+	originalConfigureClient := configureClient
+	defer func() { configureClient = originalConfigureClient }()
+	configureClient = func(client *oci_common.BaseClient) error { return nil }
+
+	password := "password"
+	clients := &OracleClients{
+		configuration:     map[string]string{"region": "us-phoenix-1"},
+		sdkConfigProvider: oci_common.NewRawConfigurationProvider(testTenancyOCID, testUserOCID, "us-phoenix-1", testKeyFingerPrint, testPrivateKey, &password),
+	}
+
+	sameRegionClients, err := clients.ForRegion("us-phoenix-1")
+	assert.Nil(t, err)
+	assert.True(t, sameRegionClients == clients)
+
+	defaultRegionClients, err := clients.ForRegion("")
+	assert.Nil(t, err)
+	assert.True(t, defaultRegionClients == clients)
+
+	ashburnClients, err := clients.ForRegion("us-ashburn-1")
+	assert.Nil(t, err)
+	assert.Equal(t, "us-ashburn-1", ashburnClients.configuration["region"])
+	assert.Equal(t, "https://iaas.us-ashburn-1.oraclecloud.com", ashburnClients.virtualNetworkClient.Host)
+	assert.Equal(t, "us-phoenix-1", clients.configuration["region"])
+
+	// Clients for a region are only created once
+	cachedClients, err := clients.ForRegion("us-ashburn-1")
+	assert.Nil(t, err)
+	assert.True(t, cachedClients == ashburnClients)
+}
+
+func TestUnitAddRegionOverride(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"compartment_id": {Type: schema.TypeString, Required: true, ForceNew: true},
+		},
+		Create: func(d *schema.ResourceData, m interface{}) error { return nil },
+		Read:   func(d *schema.ResourceData, m interface{}) error { return nil },
+		Delete: func(d *schema.ResourceData, m interface{}) error { return nil },
+	}
+	addRegionOverride(resource, true)
+	assert.NotNil(t, resource.Schema["region"])
+	assert.True(t, resource.Schema["region"].ForceNew)
+	assert.Nil(t, resource.Update)
+	assert.Nil(t, resource.InternalValidate(nil, true))
+
+	// A region attribute that belongs to the resource is not replaced
+	regionSchema := &schema.Schema{Type: schema.TypeString, Computed: true}
+	resourceWithRegion := &schema.Resource{
+		Schema: map[string]*schema.Schema{"region": regionSchema},
+	}
+	addRegionOverride(resourceWithRegion, true)
+	assert.True(t, resourceWithRegion.Schema["region"] == regionSchema)
+}
+
+func TestUnitAddRegionOverride_importAndCustomizeDiff(t *testing.T) {
+	originalConfigureClient := configureClient
+	defer func() { configureClient = originalConfigureClient }()
+	configureClient = func(client *oci_common.BaseClient) error { return nil }
+
+	password := "password"
+	clients := &OracleClients{
+		configuration:     map[string]string{"region": "us-phoenix-1"},
+		sdkConfigProvider: oci_common.NewRawConfigurationProvider(testTenancyOCID, testUserOCID, "us-phoenix-1", testKeyFingerPrint, testPrivateKey, &password),
+	}
+	ashburnClients, err := clients.ForRegion("us-ashburn-1")
+	assert.Nil(t, err)
+
+	var importClients, diffClients interface{}
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"compartment_id": {Type: schema.TypeString, Required: true, ForceNew: true},
+		},
+		Create: func(d *schema.ResourceData, m interface{}) error { return nil },
+		Read:   func(d *schema.ResourceData, m interface{}) error { return nil },
+		Delete: func(d *schema.ResourceData, m interface{}) error { return nil },
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				importClients = m
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		CustomizeDiff: func(d *schema.ResourceDiff, m interface{}) error {
+			diffClients = m
+			return nil
+		},
+	}
+	addRegionOverride(resource, true)
+
+	// Resources are imported in the region of their OCID, which is set in the state when it is not the provider region
+	d := resource.Data(nil)
+	d.SetId("ocid1.vcn.oc1.iad.aaaaaaaa")
+	_, err = resource.Importer.State(d, clients)
+	assert.Nil(t, err)
+	assert.True(t, importClients == ashburnClients)
+	assert.Equal(t, "us-ashburn-1", d.Get("region"))
+
+	d = resource.Data(nil)
+	d.SetId("ocid1.vcn.oc1.phx.aaaaaaaa")
+	_, err = resource.Importer.State(d, clients)
+	assert.Nil(t, err)
+	assert.True(t, importClients == clients)
+	assert.Equal(t, "", d.Get("region"))
+
+	// The CustomizeDiff gets the clients of the region of the resource
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"compartment_id": "ocid1.compartment.oc1..aaaaaaaa",
+		"region":         "us-ashburn-1",
+	})
+	assert.Nil(t, err)
+	_, err = resource.Diff(nil, terraform.NewResourceConfig(rawConfig), clients)
+	assert.Nil(t, err)
+	assert.True(t, diffClients == ashburnClients)
+}
+
+func TestUnitGetRegionFromImportId(t *testing.T) {
+	tests := []struct {
+		id     string
+		region string
+	}{
+		{"ocid1.vcn.oc1.iad.aaaaaaaa", "us-ashburn-1"},
+		{"ocid1.vcn.oc1.us-phoenix-1.aaaaaaaa", "us-phoenix-1"},
+		{"ocid1.compartment.oc1..aaaaaaaa", ""},
+		{"vaults/ocid1.vault.oc1.fra.aaaaaaaa/keys/ocid1.key.oc1.fra.aaaaaaaa", "eu-frankfurt-1"},
+		{"ocid1.vcn.oc1.xyz.aaaaaaaa", ""},
+		{"my-bucket", ""},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.region, getRegionFromImportId(test.id), test.id)
+	}
+}
+
+func TestUnitAddOcidValidation(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"compartment_id":  {Type: schema.TypeString, Required: true},
+			"source_id":       {Type: schema.TypeString, Optional: true},
+			"zone_name_or_id": {Type: schema.TypeString, Required: true},
+			"vcn_id":          {Type: schema.TypeString, Computed: true},
+			"create_vnic_details": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {Type: schema.TypeString, Required: true},
+					},
+				},
+			},
+		},
+	}
+	addOcidValidation(resource)
+	assert.Nil(t, resource.Schema["zone_name_or_id"].ValidateFunc)
+	assert.Nil(t, resource.Schema["vcn_id"].ValidateFunc)
+	assert.Nil(t, resource.InternalValidate(nil, true))
+
+	validate := func(field *schema.Schema, value string) []error {
+		_, errs := field.ValidateFunc(value, "attr")
+		return errs
+	}
+
+	// Any resource type is accepted for attributes that are not known to take a specific resource
+	sourceId := resource.Schema["source_id"]
+	assert.Empty(t, validate(sourceId, "ocid1.image.oc1.phx.aaaaaaaa"))
+	assert.Empty(t, validate(sourceId, "ocid1.bootvolume.oc1.iad.abuwcljr.aaaaaaaa"))
+	assert.Empty(t, validate(sourceId, ""))
+	assert.NotEmpty(t, validate(sourceId, "ocid1.image.oc1.phx"))
+	assert.NotEmpty(t, validate(sourceId, "ocid.image.oc1.phx.aaaaaaaa"))
+	assert.NotEmpty(t, validate(sourceId, " ocid1.image.oc1.phx.aaaaaaaa"))
+
+	// The resource type of the OCID is checked for known attributes
+	compartmentId := resource.Schema["compartment_id"]
+	assert.Empty(t, validate(compartmentId, "ocid1.compartment.oc1..aaaaaaaa"))
+	assert.Empty(t, validate(compartmentId, "ocid1.tenancy.oc1..aaaaaaaa"))
+	errs := validate(compartmentId, "ocid1.vcn.oc1.phx.aaaaaaaa")
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "expected attr to be the OCID of a compartment or tenancy, got the OCID of a vcn: ocid1.vcn.oc1.phx.aaaaaaaa", errs[0].Error())
+
+	subnetId := resource.Schema["create_vnic_details"].Elem.(*schema.Resource).Schema["subnet_id"]
+	assert.Empty(t, validate(subnetId, "ocid1.subnet.oc1.phx.aaaaaaaa"))
+	assert.NotEmpty(t, validate(subnetId, "ocid1.vcn.oc1.phx.aaaaaaaa"))
+}
+
+// ensure the private key and its passphrase can be passed inline or through environment variables
+func TestUnitResourceDataConfigProvider_inlinePrivateKey(t *testing.T) {
+	for _, envVar := range []string{ociVarName(privateKeyAttrName), ociVarName(privateKeyPasswordAttrName)} {
+		prevEnvVar, hadPreviousEnvVar := os.LookupEnv(envVar)
+		if hadPreviousEnvVar {
+			defer os.Setenv(envVar, prevEnvVar)
+		} else {
+			defer os.Unsetenv(envVar)
+		}
+	}
+
+	escapedPrivateKey := strings.Replace(testPrivateKey, "\n", `\n`, -1)
+	os.Setenv(ociVarName(privateKeyAttrName), escapedPrivateKey)
+	os.Setenv(ociVarName(privateKeyPasswordAttrName), "password")
+
+	providerSchema := schemaMap()
+	privateKey, err := providerSchema[privateKeyAttrName].DefaultValue()
+	assert.NoError(t, err)
+	assert.Equal(t, escapedPrivateKey, privateKey)
+	password, err := providerSchema[privateKeyPasswordAttrName].DefaultValue()
+	assert.NoError(t, err)
+	assert.Equal(t, "password", password)
+
+	d := schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{})
+	configProvider := ResourceDataConfigProvider{d}
+	key, err := configProvider.PrivateRSAKey()
+	assert.NoError(t, err)
+	assert.NotNil(t, key)
+
+	assert.Equal(t, testPrivateKey, normalizePrivateKeyPem(escapedPrivateKey))
+	assert.Equal(t, testPrivateKey, normalizePrivateKeyPem(testPrivateKey))
+}
+
+// ensure clients for resource specific endpoints are created once per endpoint
+func TestUnitOracleClientsEndpointClients(t *testing.T) {
+	originalConfigureClient := configureClient
+	defer func() { configureClient = originalConfigureClient }()
+	configureClient = func(client *oci_common.BaseClient) error { return nil }
+
+	password := "password"
+	configProvider := oci_common.NewRawConfigurationProvider(testTenancyOCID, testUserOCID, "us-phoenix-1", testKeyFingerPrint, testPrivateKey, &password)
+	clients := &OracleClients{configuration: map[string]string{}}
+	assert.NoError(t, createSDKClients(clients, configProvider, configureClient))
+
+	managementClient, err := clients.KmsManagementClient("https://vault1-management.kms.us-phoenix-1.oraclecloud.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://vault1-management.kms.us-phoenix-1.oraclecloud.com", managementClient.Host)
+
+	cachedManagementClient, err := clients.KmsManagementClient("https://vault1-management.kms.us-phoenix-1.oraclecloud.com")
+	assert.NoError(t, err)
+	assert.True(t, cachedManagementClient == managementClient)
+
+	otherManagementClient, err := clients.KmsManagementClient("https://vault2-management.kms.us-phoenix-1.oraclecloud.com")
+	assert.NoError(t, err)
+	assert.False(t, otherManagementClient == managementClient)
+
+	cryptoClient, err := clients.KmsCryptoClient("https://vault1-crypto.kms.us-phoenix-1.oraclecloud.com")
+	assert.NoError(t, err)
+	cachedCryptoClient, err := clients.KmsCryptoClient("https://vault1-crypto.kms.us-phoenix-1.oraclecloud.com")
+	assert.NoError(t, err)
+	assert.True(t, cachedCryptoClient == cryptoClient)
+}
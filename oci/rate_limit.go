@@ -0,0 +1,54 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"net/http"
+	"sync"
+
+	"golang.org/x/time/rate"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+)
+
+var configuredRequestsPerSecond float64
+var rateLimiters = map[string]*rate.Limiter{}
+var rateLimitersLock sync.Mutex
+
+// rateLimitedDispatcher throttles requests before handing them to the wrapped dispatcher, so that large
+// applies stay under the OCI API throttling limits instead of relying on retries of 429 errors
+type rateLimitedDispatcher struct {
+	dispatcher oci_common.HTTPRequestDispatcher
+}
+
+func (r rateLimitedDispatcher) Do(req *http.Request) (*http.Response, error) {
+	if err := getRateLimiter(req.URL.Host).Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return r.dispatcher.Do(req)
+}
+
+// Each service endpoint gets its own limiter, the host identifies both the service and the region
+func getRateLimiter(host string) *rate.Limiter {
+	rateLimitersLock.Lock()
+	defer rateLimitersLock.Unlock()
+
+	limiter, ok := rateLimiters[host]
+	if !ok {
+		burst := int(configuredRequestsPerSecond)
+		if burst < 1 {
+			burst = 1
+		}
+		limiter = rate.NewLimiter(rate.Limit(configuredRequestsPerSecond), burst)
+		rateLimiters[host] = limiter
+	}
+	return limiter
+}
+
+func setRequestsPerSecond(requestsPerSecond float64) {
+	rateLimitersLock.Lock()
+	defer rateLimitersLock.Unlock()
+
+	configuredRequestsPerSecond = requestsPerSecond
+	rateLimiters = map[string]*rate.Limiter{}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+type countingDispatcher struct {
+	count int
+}
+
+func (c *countingDispatcher) Do(req *http.Request) (*http.Response, error) {
+	c.count++
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func TestUnitRateLimiter_perHost(t *testing.T) {
+	setRequestsPerSecond(0.5)
+	defer setRequestsPerSecond(0)
+
+	coreLimiter := getRateLimiter("iaas.us-phoenix-1.oraclecloud.com")
+	if coreLimiter.Limit() != rate.Limit(0.5) || coreLimiter.Burst() != 1 {
+		t.Errorf("unexpected limiter settings, limit: %v burst: %v", coreLimiter.Limit(), coreLimiter.Burst())
+	}
+	if getRateLimiter("iaas.us-phoenix-1.oraclecloud.com") != coreLimiter {
+		t.Errorf("expected the same limiter to be returned for the same host")
+	}
+	if getRateLimiter("kms.us-phoenix-1.oraclecloud.com") == coreLimiter {
+		t.Errorf("expected a separate limiter for each host")
+	}
+}
+
+func TestUnitRateLimitedDispatcher_Do(t *testing.T) {
+	setRequestsPerSecond(5)
+	defer setRequestsPerSecond(0)
+
+	dispatcher := &countingDispatcher{}
+	client := rateLimitedDispatcher{dispatcher: dispatcher}
+
+	// The first requests are allowed by the burst, the following ones have to wait for the limiter
+	start := time.Now()
+	for i := 0; i < 7; i++ {
+		req, _ := http.NewRequest(http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns", nil)
+		if _, err := client.Do(req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if dispatcher.count != 7 {
+		t.Errorf("expected 7 requests to be dispatched, got %d", dispatcher.count)
+	}
+	if elapsed := time.Since(start); elapsed < 300*time.Millisecond {
+		t.Errorf("expected requests above the burst to be throttled, took %v", elapsed)
+	}
+}
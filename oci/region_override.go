@@ -0,0 +1,126 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+)
+
+const regionOverrideAttrName = "region"
+
+// addRegionOverride adds an optional region argument to a resource or data source, so it can be managed in a
+// different region than the one configured in the provider without declaring an aliased provider for that region.
+// Resources that already have a region attribute of their own are left as they are.
+func addRegionOverride(resource *schema.Resource, isResource bool) {
+	if resource == nil || resource.Schema == nil {
+		return
+	}
+	if _, ok := resource.Schema[regionOverrideAttrName]; ok {
+		return
+	}
+
+	resource.Schema[regionOverrideAttrName] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: isResource,
+	}
+
+	resource.Create = withRegionClients(resource.Create)
+	resource.Read = withRegionClients(resource.Read)
+	resource.Update = withRegionClients(resource.Update)
+	resource.Delete = withRegionClients(resource.Delete)
+	resource.CustomizeDiff = withRegionClientsForDiff(resource.CustomizeDiff)
+	if resource.Importer != nil {
+		resource.Importer.State = withRegionClientsForImport(resource.Importer.State)
+	}
+}
+
+func withRegionClients(fn func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if fn == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients, ok := m.(*OracleClients)
+		if !ok {
+			return fn(d, m)
+		}
+		region, _ := d.Get(regionOverrideAttrName).(string)
+		regionClients, err := clients.ForRegion(region)
+		if err != nil {
+			return err
+		}
+		return fn(d, regionClients)
+	}
+}
+
+// withRegionClientsForDiff passes the clients of the region of a resource to its CustomizeDiff. When the clients of
+// the region cannot be created the provider clients are passed instead, so the plan is not blocked by a check that
+// reads from the service, and the apply reports the error.
+func withRegionClientsForDiff(fn schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	if fn == nil {
+		return nil
+	}
+	return func(d *schema.ResourceDiff, m interface{}) error {
+		clients, ok := m.(*OracleClients)
+		if !ok || !d.NewValueKnown(regionOverrideAttrName) {
+			return fn(d, m)
+		}
+		region, _ := d.Get(regionOverrideAttrName).(string)
+		regionClients, err := clients.ForRegion(region)
+		if err != nil {
+			log.Printf("[WARN] Could not create the clients for region %s to plan the resource: %v", region, err)
+			return fn(d, m)
+		}
+		return fn(d, regionClients)
+	}
+}
+
+// withRegionClientsForImport looks up imported resources in the region of their OCID, since the region argument of
+// the configuration is not available when a resource is imported. The region is only set in the state when it is
+// not the region of the provider, so resources in the region of the provider are imported as before.
+func withRegionClientsForImport(fn schema.StateFunc) schema.StateFunc {
+	if fn == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		clients, ok := m.(*OracleClients)
+		if !ok {
+			return fn(d, m)
+		}
+		region := getRegionFromImportId(d.Id())
+		regionClients, err := clients.ForRegion(region)
+		if err != nil {
+			return nil, err
+		}
+		if regionClients != clients {
+			if err := d.Set(regionOverrideAttrName, region); err != nil {
+				return nil, err
+			}
+		}
+		return fn(d, regionClients)
+	}
+}
+
+// getRegionFromImportId returns the region of the first OCID in an import ID, such as `ocid1.vcn.oc1.phx.aaaa` or
+// `vaults/{vaultId}/keys/{keyId}`, or an empty string if none of them is regional.
+// OCIDs have the format `ocid1.<resource type>.<realm>.<region>.<unique id>`, and the region is empty for resources
+// that are not regional, like compartments, or a region key, like `phx`, for older OCIDs.
+func getRegionFromImportId(id string) string {
+	for _, part := range strings.Split(id, "/") {
+		segments := strings.Split(part, ".")
+		if len(segments) < 5 || segments[0] != "ocid1" || segments[3] == "" {
+			continue
+		}
+		region := string(oci_common.StringToRegion(segments[3]))
+		if region == segments[3] && !strings.Contains(region, "-") {
+			// An unknown region key
+			continue
+		}
+		return region
+	}
+	return ""
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+)
+
+const redactedValue = "<redacted>"
+
+// Headers that carry credentials are never logged, nor are headers with names that suggest secret values
+var redactedHeaders = map[string]bool{
+	"authorization": true,
+	"opc-obo-token": true,
+}
+var redactedHeaderNameParts = []string{"key", "password", "secret", "token", "passphrase", "credential"}
+
+// loggingDispatcher logs every request made by the SDK clients and the status of its response, so failures can be
+// traced with the opc-request-id. Bodies are never logged since they may contain keys, passwords or wallets.
+type loggingDispatcher struct {
+	dispatcher oci_common.HTTPRequestDispatcher
+}
+
+func (l loggingDispatcher) Do(req *http.Request) (*http.Response, error) {
+	log.Printf("[DEBUG] OCI request: %s %s headers: %s", req.Method, req.URL.String(), redactHeaders(req.Header))
+
+	startTime := time.Now()
+	response, err := l.dispatcher.Do(req)
+	duration := time.Since(startTime)
+	if err != nil {
+		log.Printf("[DEBUG] OCI request failed: %s %s duration: %v error: %v", req.Method, req.URL.String(), duration, err)
+		return response, err
+	}
+
+	log.Printf("[DEBUG] OCI response: %s %s status: %d opc-request-id: %s duration: %v",
+		req.Method, req.URL.String(), response.StatusCode, response.Header.Get("opc-request-id"), duration)
+	return response, err
+}
+
+// redactHeaders formats the headers for logging with the values of sensitive headers replaced
+func redactHeaders(headers http.Header) string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	formatted := make([]string, 0, len(names))
+	for _, name := range names {
+		value := strings.Join(headers[name], ",")
+		if isRedactedHeader(name) {
+			value = redactedValue
+		}
+		formatted = append(formatted, name+"="+value)
+	}
+	return "[" + strings.Join(formatted, " ") + "]"
+}
+
+func isRedactedHeader(name string) bool {
+	lowerCaseName := strings.ToLower(name)
+	if redactedHeaders[lowerCaseName] {
+		return true
+	}
+	for _, part := range redactedHeaderNameParts {
+		if strings.Contains(lowerCaseName, part) {
+			return true
+		}
+	}
+	return false
+}
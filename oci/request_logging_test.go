@@ -0,0 +1,35 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitRedactHeaders(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("Authorization", `Signature version="1",keyId="ocid1.tenancy/ocid1.user/fingerprint",signature="abc"`)
+	headers.Set("opc-obo-token", "token")
+	headers.Set("opc-request-id", "request-id")
+	headers.Set("X-Wallet-Password", "password")
+	headers.Set("Content-Type", "application/json")
+
+	formatted := redactHeaders(headers)
+	assert.Equal(t, "[Authorization=<redacted> Content-Type=application/json Opc-Obo-Token=<redacted> Opc-Request-Id=request-id X-Wallet-Password=<redacted>]", formatted)
+	assert.False(t, strings.Contains(formatted, "signature"))
+}
+
+func TestUnitLoggingDispatcher_Do(t *testing.T) {
+	dispatcher := &countingDispatcher{}
+	client := loggingDispatcher{dispatcher: dispatcher}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://kms.us-phoenix-1.oraclecloud.com/20180608/vaults", nil)
+	response, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, 1, dispatcher.count)
+}
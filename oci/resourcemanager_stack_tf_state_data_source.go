@@ -4,6 +4,7 @@ package oci
 
 import (
 	"context"
+	"encoding/json"
 	"io/ioutil"
 	"log"
 
@@ -28,6 +29,11 @@ func ResourcemanagerStackTfStateDataSource() *schema.Resource {
 				Required: true,
 			},
 			// Computed
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     schema.TypeString,
+			},
 		},
 	}
 }
@@ -78,6 +84,8 @@ func (s *ResourcemanagerStackTfStateDataSourceCrud) SetData() error {
 
 	path, _ := s.D.GetOkExists("local_path")
 
+	defer s.Res.Content.Close()
+
 	byteArr, err := ioutil.ReadAll(s.Res.Content)
 	if err != nil {
 		log.Printf("Unable to read Stack Tf State from response. Error: %q", err)
@@ -90,5 +98,42 @@ func (s *ResourcemanagerStackTfStateDataSourceCrud) SetData() error {
 		return err
 	}
 
+	outputs, err := parseStackTfStateOutputs(byteArr)
+	if err != nil {
+		log.Printf("Unable to parse outputs from Stack Tf State. Error: %q", err)
+		return err
+	}
+	s.D.Set("outputs", outputs)
+
 	return nil
 }
+
+// Returns the root module outputs of a Terraform state file. Outputs that are not strings
+// are returned as their JSON encoding so that they can be decoded with jsondecode().
+func parseStackTfStateOutputs(stateContent []byte) (map[string]string, error) {
+	var state struct {
+		Outputs map[string]struct {
+			Value interface{} `json:"value"`
+		} `json:"outputs"`
+	}
+
+	if err := json.Unmarshal(stateContent, &state); err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	for name, output := range state.Outputs {
+		if value, ok := output.Value.(string); ok {
+			result[name] = value
+			continue
+		}
+
+		value, err := json.Marshal(output.Value)
+		if err != nil {
+			return nil, err
+		}
+		result[name] = string(value)
+	}
+
+	return result, nil
+}
@@ -73,3 +73,38 @@ func TestResourcemanagerStackTfStateResource_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestUnitResourcemanagerStackTfState_parseOutputs(t *testing.T) {
+	state := `{
+		"version": 4,
+		"outputs": {
+			"vcn_id": {"value": "ocid1.vcn.oc1..aaaa", "type": "string"},
+			"subnet_ids": {"value": ["ocid1.subnet.oc1..aaaa", "ocid1.subnet.oc1..bbbb"], "type": ["list", "string"]},
+			"instance_count": {"value": 3, "type": "number"}
+		},
+		"resources": []
+	}`
+
+	outputs, err := parseStackTfStateOutputs([]byte(state))
+	if err != nil {
+		t.Fatalf("unexpected error parsing state outputs: %v", err)
+	}
+
+	expected := map[string]string{
+		"vcn_id":         "ocid1.vcn.oc1..aaaa",
+		"subnet_ids":     `["ocid1.subnet.oc1..aaaa","ocid1.subnet.oc1..bbbb"]`,
+		"instance_count": "3",
+	}
+	if len(outputs) != len(expected) {
+		t.Errorf("expected %d outputs, got %d", len(expected), len(outputs))
+	}
+	for name, value := range expected {
+		if outputs[name] != value {
+			t.Errorf("expected output %s to be '%s', got '%s'", name, value, outputs[name])
+		}
+	}
+
+	if _, err := parseStackTfStateOutputs([]byte("not a state file")); err == nil {
+		t.Errorf("expected an error parsing an invalid state file")
+	}
+}
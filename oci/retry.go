@@ -21,6 +21,7 @@ const (
 	waasService          = "waas"
 	kmsService           = "kms"
 	objectstorageService = "object_storage"
+	fileStorageService   = "file_storage"
 	deleteResource       = "delete"
 	updateResource       = "update"
 	createResource       = "create"
@@ -34,6 +35,7 @@ type getRetryPolicyFunc func(disableNotFoundRetries bool, service string, option
 var serviceExpectedRetryDurationMap = map[string]serviceExpectedRetryDurationFunc{
 	coreService:          getCoreExpectedRetryDuration,
 	databaseService:      getDatabaseExpectedRetryDuration,
+	fileStorageService:   getFileStorageExpectedRetryDuration,
 	identityService:      getIdentityExpectedRetryDuration,
 	objectstorageService: getObjectstorageServiceExpectedRetryDuration,
 	waasService:          getWaasExpectedRetryDuration,
@@ -45,6 +47,9 @@ var serviceRetryPolicyFnMap = map[string]getRetryPolicyFunc{
 var shortRetryTime = 2 * time.Minute
 var longRetryTime = 10 * time.Minute
 var configuredRetryDuration *time.Duration
+var configuredMaxRetries *int
+var configuredRetryableStatusCodes map[int]bool
+var configuredServiceRetryDurations map[string]time.Duration
 
 func init() {
 	rand.Seed(time.Now().UnixNano())
@@ -88,6 +93,25 @@ func getElapsedRetryDuration(firstAttemptTime time.Time) time.Duration {
 }
 
 func getExpectedRetryDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, optionals ...interface{}) time.Duration {
+	expectedRetryDuration := getServiceExpectedRetryDuration(response, disableNotFoundRetries, service, optionals...)
+
+	// A retry duration configured for the service in retry_overrides replaces the duration of errors that are retried
+	if retryDuration, ok := configuredServiceRetryDurations[service]; ok && expectedRetryDuration > 0 {
+		return retryDuration
+	}
+	return expectedRetryDuration
+}
+
+func getServiceExpectedRetryDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, optionals ...interface{}) time.Duration {
+	// Status codes configured as retryable in the provider take precedence over the service specific behavior
+	if response.Response != nil && response.Response.HTTPResponse() != nil {
+		if configuredRetryableStatusCodes[response.Response.HTTPResponse().StatusCode] {
+			if configuredRetryDuration != nil {
+				return *configuredRetryDuration
+			}
+			return longRetryTime
+		}
+	}
 
 	if retryDurationFn, ok := serviceExpectedRetryDurationMap[service]; ok {
 		return retryDurationFn(response, disableNotFoundRetries, optionals...)
@@ -217,10 +241,18 @@ func shouldRetry(response oci_common.OCIOperationResponse, disableNotFoundRetrie
 // Because this function notes the start time for making should retry decisions, it's advised
 // for this function call to be made immediately before the client API call.
 func getRetryPolicy(disableNotFoundRetries bool, service string, optionals ...interface{}) *oci_common.RetryPolicy {
+	var retryPolicy *oci_common.RetryPolicy
 	if serviceRetryPolicyFn, ok := serviceRetryPolicyFnMap[service]; ok {
-		return serviceRetryPolicyFn(disableNotFoundRetries, service, optionals...)
+		retryPolicy = serviceRetryPolicyFn(disableNotFoundRetries, service, optionals...)
+	} else {
+		retryPolicy = getDefaultRetryPolicy(disableNotFoundRetries, service, optionals...)
 	}
-	return getDefaultRetryPolicy(disableNotFoundRetries, service, optionals...)
+
+	// The SDK counts the first attempt, so allow one more attempt than the configured number of retries
+	if configuredMaxRetries != nil && *configuredMaxRetries >= 0 {
+		retryPolicy.MaximumNumberAttempts = uint(*configuredMaxRetries + 1)
+	}
+	return retryPolicy
 }
 
 func getDefaultRetryPolicy(disableNotFoundRetries bool, service string, optionals ...interface{}) *oci_common.RetryPolicy {
@@ -312,3 +312,125 @@ func TestUnitRetryObjectStorage(t *testing.T) {
 	}
 	retryLoop(t, &r)
 }
+
+func TestUnitRetrySnapshot409Conflict(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip Retry Tests in HttpReplay mode.")
+	}
+	shortRetryTime = 15 * time.Second
+	longRetryTime = 30 * time.Second
+	configuredRetryDuration = nil
+
+	r := retryTestInput{
+		serviceName:              fileStorageService,
+		httpResponseStatusCode:   409,
+		header:                   map[string][]string{},
+		responseError:            fmt.Errorf("Conflict"),
+		optionals:                []interface{}{snapshotService},
+		expectedRetryTimeSeconds: 0,
+		jitterMode:               true,
+	}
+	retryLoop(t, &r)
+}
+
+func TestUnitRetrySnapshot409IncorrectState(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip Retry Tests in HttpReplay mode.")
+	}
+	shortRetryTime = 15 * time.Second
+	longRetryTime = 30 * time.Second
+	configuredRetryDuration = nil
+
+	r := retryTestInput{
+		serviceName:              fileStorageService,
+		httpResponseStatusCode:   409,
+		header:                   map[string][]string{},
+		responseError:            fmt.Errorf("IncorrectState"),
+		optionals:                []interface{}{snapshotService},
+		expectedRetryTimeSeconds: 15,
+		jitterMode:               true,
+	}
+	retryLoop(t, &r)
+}
+
+// Status codes configured as retryable should be retried even if the service would not retry them
+func TestUnitRetryConfiguredRetryableStatusCodes(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip Retry Tests in HttpReplay mode.")
+	}
+	shortRetryTime = 15 * time.Second
+	longRetryTime = 30 * time.Second
+	configuredRetryDuration = nil
+	configuredRetryableStatusCodes = map[int]bool{409: true}
+	defer func() { configuredRetryableStatusCodes = nil }()
+
+	response := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 409}, fmt.Errorf("InvalidatedRetryToken"), 1)
+	if duration := getExpectedRetryDuration(response, true, "database"); duration != longRetryTime {
+		t.Errorf("Expected retry duration %v for configured status code, but got %v", longRetryTime, duration)
+	}
+
+	tmp := time.Duration(60 * time.Second)
+	configuredRetryDuration = &tmp
+	defer func() { configuredRetryDuration = nil }()
+	if duration := getExpectedRetryDuration(response, true, "database"); duration != tmp {
+		t.Errorf("Expected retry duration %v for configured status code, but got %v", tmp, duration)
+	}
+
+	response = common.NewOCIOperationResponse(TestOCIResponse{statusCode: 400}, fmt.Errorf("Bad request"), 1)
+	if duration := getExpectedRetryDuration(response, true, "database"); duration != 0 {
+		t.Errorf("Expected no retry for status code that is not configured, but got %v", duration)
+	}
+}
+
+// The configured number of retries should limit the attempts made by every retry policy
+func TestUnitRetryConfiguredMaxRetries(t *testing.T) {
+	if policy := getRetryPolicy(false, "core"); policy.MaximumNumberAttempts != 0 {
+		t.Errorf("Expected unlimited attempts when max retries is not configured, but got %v", policy.MaximumNumberAttempts)
+	}
+
+	maxRetries := 3
+	configuredMaxRetries = &maxRetries
+	defer func() { configuredMaxRetries = nil }()
+	for _, service := range []string{"core", kmsService} {
+		if policy := getRetryPolicy(false, service); policy.MaximumNumberAttempts != 4 {
+			t.Errorf("Expected 4 attempts for service %s, but got %v", service, policy.MaximumNumberAttempts)
+		}
+	}
+}
+
+// A retry duration configured for a service should replace its retry duration, but not make errors retriable
+func TestUnitRetryServiceRetryOverrides(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip Retry Tests in HttpReplay mode.")
+	}
+	shortRetryTime = 15 * time.Second
+	longRetryTime = 30 * time.Second
+	configuredRetryDuration = nil
+
+	serviceRetryDurations, err := getServiceRetryDurations(map[string]interface{}{"Identity": 0, "database": "60", "core": -1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	configuredServiceRetryDurations = serviceRetryDurations
+	defer func() { configuredServiceRetryDurations = nil }()
+
+	throttled := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 429}, fmt.Errorf("TooManyRequests"), 1)
+	if duration := getExpectedRetryDuration(throttled, false, "identity"); duration != 0 {
+		t.Errorf("Expected no retries for identity, but got %v", duration)
+	}
+	if duration := getExpectedRetryDuration(throttled, false, "database"); duration != 60*time.Second {
+		t.Errorf("Expected 60s of retries for database, but got %v", duration)
+	}
+	if duration := getExpectedRetryDuration(throttled, false, "kms"); duration != longRetryTime {
+		t.Errorf("Expected the default retry duration for kms, but got %v", duration)
+	}
+
+	badRequest := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 400}, fmt.Errorf("InvalidParameter"), 1)
+	if duration := getExpectedRetryDuration(badRequest, false, "core"); duration != 0 {
+		t.Errorf("Expected no retries for a 400 error, but got %v", duration)
+	}
+
+	if _, err := getServiceRetryDurations(map[string]interface{}{"core": "forever"}); err == nil {
+		t.Errorf("Expected an error for a retry duration that is not a number")
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_limits "github.com/oracle/oci-go-sdk/limits"
+)
+
+const (
+	serviceLimitsCheckWarn  = "warn"
+	serviceLimitsCheckError = "error"
+)
+
+// How check_service_limits is configured in the provider, limits are not checked when it is empty
+var serviceLimitsCheck = ""
+
+// serviceLimitUsage is the amount of a service limit that a resource uses, such as the OCPUs of an instance
+type serviceLimitUsage struct {
+	ServiceName string
+	LimitName   string
+	// Only set for limits with the AD scope
+	AvailabilityDomain string
+	Amount             int64
+}
+
+// checkServiceLimits compares the usage of a resource that is planned to be created with what is available for the
+// limits in its compartment, as reported by the Limits service with the quotas of the compartment applied. A usage
+// that is more than is available is logged as a warning, or fails the plan, depending on check_service_limits. The
+// warnings only go to the Terraform log, since the plugin SDK has no way to show warnings in the plan output.
+// Limits that cannot be read, for example without permission to read them, are skipped so the check never blocks a
+// plan that would otherwise succeed.
+func checkServiceLimits(d *schema.ResourceDiff, meta interface{}, usages ...serviceLimitUsage) error {
+	if serviceLimitsCheck == "" || d.Id() != "" || !d.NewValueKnown("compartment_id") {
+		return nil
+	}
+	clients, ok := meta.(*OracleClients)
+	if !ok || clients.limitsClient == nil {
+		return nil
+	}
+	region, _ := d.Get(regionOverrideAttrName).(string)
+	clients, err := clients.ForRegion(region)
+	if err != nil {
+		log.Printf("[WARN] Could not check the service limits in region %s: %v", region, err)
+		return nil
+	}
+
+	compartmentId := d.Get("compartment_id").(string)
+	for _, usage := range usages {
+		request := oci_limits.GetResourceAvailabilityRequest{
+			CompartmentId: &compartmentId,
+			ServiceName:   &usage.ServiceName,
+			LimitName:     &usage.LimitName,
+		}
+		if usage.AvailabilityDomain != "" {
+			tmp := usage.AvailabilityDomain
+			request.AvailabilityDomain = &tmp
+		}
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(true, "limits")
+
+		response, err := clients.limitsClient.GetResourceAvailability(context.Background(), request)
+		if err != nil {
+			log.Printf("[WARN] Could not check the %s limit %s of compartment %s: %v", usage.ServiceName, usage.LimitName, compartmentId, err)
+			continue
+		}
+		if response.Available == nil || *response.Available >= usage.Amount {
+			continue
+		}
+
+		message := fmt.Sprintf("the %s limit %s of compartment %s has %d available, but %d are needed", usage.ServiceName, usage.LimitName, compartmentId, *response.Available, usage.Amount)
+		if usage.AvailabilityDomain != "" {
+			message = fmt.Sprintf("%s in %s", message, usage.AvailabilityDomain)
+		}
+		if serviceLimitsCheck == serviceLimitsCheckError {
+			return fmt.Errorf("%s. Request a service limit increase, or set %s to %s to plan anyway", message, checkServiceLimitsAttrName, serviceLimitsCheckWarn)
+		}
+		log.Printf("[WARN] The resource cannot be created: %s", message)
+	}
+	return nil
+}
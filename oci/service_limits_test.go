@@ -0,0 +1,82 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/terraform"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_limits "github.com/oracle/oci-go-sdk/limits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitCheckServiceLimits(t *testing.T) {
+	defer func() { serviceLimitsCheck = "" }()
+
+	var requestedPaths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPaths = append(requestedPaths, r.URL.Path+"?"+r.URL.RawQuery)
+		if strings.Contains(r.URL.Path, "unknown") {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":"NotAuthorizedOrNotFound","message":"not found"}`)
+			return
+		}
+		fmt.Fprint(w, `{"used":950,"available":50}`)
+	}))
+	defer server.Close()
+
+	password := "password"
+	client, err := oci_limits.NewLimitsClientWithConfigurationProvider(oci_common.NewRawConfigurationProvider(testTenancyOCID, testUserOCID, "us-phoenix-1", testKeyFingerPrint, testPrivateKey, &password))
+	assert.Nil(t, err)
+	client.Host = server.URL
+	clients := &OracleClients{limitsClient: &client}
+
+	planVolume := func(sizeInGBs string) error {
+		rawConfig, err := config.NewRawConfig(map[string]interface{}{
+			"compartment_id":      "ocid1.compartment.oc1..aaaa",
+			"availability_domain": "AD-1",
+			"size_in_gbs":         sizeInGBs,
+		})
+		assert.Nil(t, err)
+		_, err = CoreVolumeResource().Diff(nil, terraform.NewResourceConfig(rawConfig), clients)
+		return err
+	}
+
+	// Limits are not checked unless check_service_limits is set
+	assert.Nil(t, planVolume("100"))
+	assert.Empty(t, requestedPaths)
+
+	// Usage over what is available fails the plan when check_service_limits is error
+	serviceLimitsCheck = serviceLimitsCheckError
+	err = planVolume("100")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the block-storage limit total-storage-gb of compartment ocid1.compartment.oc1..aaaa has 50 available, but 100 are needed in AD-1")
+	assert.Len(t, requestedPaths, 1)
+	assert.Contains(t, requestedPaths[0], "/services/block-storage/limits/total-storage-gb/resourceAvailability")
+	assert.Contains(t, requestedPaths[0], "availabilityDomain=AD-1")
+
+	assert.Nil(t, planVolume("50"))
+
+	// It is only logged when check_service_limits is warn
+	serviceLimitsCheck = serviceLimitsCheckWarn
+	assert.Nil(t, planVolume("100"))
+
+	// Limits that cannot be read are skipped
+	serviceLimitsCheck = serviceLimitsCheckError
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"compartment_id": "ocid1.compartment.oc1..aaaa",
+		"shape":          "unknown",
+		"subnet_ids":     []interface{}{"ocid1.subnet.oc1..aaaa"},
+		"display_name":   "lb",
+	})
+	assert.Nil(t, err)
+	_, err = LoadBalancerLoadBalancerResource().Diff(nil, terraform.NewResourceConfig(rawConfig), clients)
+	assert.Nil(t, err)
+	assert.Contains(t, requestedPaths[len(requestedPaths)-1], "/services/load-balancer/limits/lb-unknown-count/resourceAvailability")
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// registerSingularDataSources registers a data source with the name of each importable resource that does not have a
+// data source of its own. It takes the identifier of the resource in its id argument, and reads the resource the
+// same way it is read when it is imported.
+func registerSingularDataSources() {
+	for name, resource := range OciResources {
+		if _, ok := OciDatasources[name]; ok || resource.Importer == nil || resource.Read == nil {
+			continue
+		}
+		RegisterDatasource(name, singularDataSourceFromResource(name, resource))
+	}
+}
+
+func singularDataSourceFromResource(name string, resource *schema.Resource) *schema.Resource {
+	dataSourceSchema := copyResourceSchema(resource)
+	// The region override is added back as an argument when the data source is registered
+	delete(dataSourceSchema.Schema, regionOverrideAttrName)
+
+	fieldMap := make(map[string]*schema.Schema)
+	fieldMap["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	return GetSingularDataSourceItemSchema(dataSourceSchema, fieldMap, readSingularDataSourceFromResource(name, resource))
+}
+
+func readSingularDataSourceFromResource(name string, resource *schema.Resource) schema.ReadFunc {
+	return func(d *schema.ResourceData, m interface{}) error {
+		id := d.Get("id").(string)
+		d.SetId(id)
+
+		// Importers set the arguments that resources with composite identifiers need to be read
+		if resource.Importer.State != nil {
+			if _, err := resource.Importer.State(d, m); err != nil {
+				return err
+			}
+		}
+
+		if err := resource.Read(d, m); err != nil {
+			return err
+		}
+		if d.Id() == "" {
+			return fmt.Errorf("%s %s was not found", name, id)
+		}
+		return nil
+	}
+}
+
+// copyResourceSchema returns a copy of the schema of a resource, to be converted to the schema of a data source
+// without changing the resource
+func copyResourceSchema(resource *schema.Resource) *schema.Resource {
+	result := &schema.Resource{Schema: make(map[string]*schema.Schema, len(resource.Schema))}
+	for name, fieldSchema := range resource.Schema {
+		fieldSchemaCopy := *fieldSchema
+		switch elem := fieldSchema.Elem.(type) {
+		case *schema.Resource:
+			fieldSchemaCopy.Elem = copyResourceSchema(elem)
+		case *schema.Schema:
+			elemCopy := *elem
+			fieldSchemaCopy.Elem = &elemCopy
+		}
+		result.Schema[name] = &fieldSchemaCopy
+	}
+	return result
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitSingularDataSourceFromResource(t *testing.T) {
+	resource := &schema.Resource{
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			if strings.HasSuffix(d.Id(), "/missing") {
+				d.SetId("")
+				return nil
+			}
+			return d.Set("display_name", "name of "+d.Id())
+		},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				d.Set("parent_id", strings.Split(d.Id(), "/")[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"parent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+	addRegionOverride(resource, true)
+
+	datasource := singularDataSourceFromResource("oci_test_resource", resource)
+	addRegionOverride(datasource, false)
+	assert.Nil(t, datasource.InternalValidate(nil, false))
+	assert.True(t, datasource.Schema["id"].Required)
+	assert.True(t, datasource.Schema["region"].Optional)
+	assert.True(t, datasource.Schema["parent_id"].Computed)
+	assert.False(t, datasource.Schema["parent_id"].Required)
+
+	// The schema of the resource is left as it is
+	assert.True(t, resource.Schema["parent_id"].Required)
+	assert.True(t, resource.Schema["region"].ForceNew)
+
+	// The resource is read by its identifier, with the arguments set by its importer
+	d := schema.TestResourceDataRaw(t, datasource.Schema, map[string]interface{}{"id": "parents/parent/children/child"})
+	assert.Nil(t, datasource.Read(d, nil))
+	assert.Equal(t, "parents/parent/children/child", d.Id())
+	assert.Equal(t, "parent", d.Get("parent_id"))
+	assert.Equal(t, "name of parents/parent/children/child", d.Get("display_name"))
+
+	// A resource that is not found is an error
+	d = schema.TestResourceDataRaw(t, datasource.Schema, map[string]interface{}{"id": "parents/parent/children/missing"})
+	assert.Error(t, datasource.Read(d, nil))
+}
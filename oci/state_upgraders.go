@@ -0,0 +1,37 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// addStateUpgraders sets the schema version of a resource to the number of upgrade functions, and adds a
+// StateUpgrader for each of them, in order. The upgrade function at index i upgrades the state of version i to i+1.
+//
+// The type of every prior version is taken from the current schema, which is only correct while attributes are
+// added or deprecated between versions. An attribute that is removed or that changes type needs a StateUpgrader
+// with the type of the schema it was removed from, instead of this helper.
+func addStateUpgraders(resource *schema.Resource, upgrades ...schema.StateUpgradeFunc) *schema.Resource {
+	if resource == nil || len(upgrades) == 0 {
+		return resource
+	}
+
+	priorType := resource.CoreConfigSchema().ImpliedType()
+	resource.SchemaVersion = len(upgrades)
+	resource.StateUpgraders = make([]schema.StateUpgrader, len(upgrades))
+	for version, upgrade := range upgrades {
+		resource.StateUpgraders[version] = schema.StateUpgrader{
+			Version: version,
+			Type:    priorType,
+			Upgrade: upgrade,
+		}
+	}
+	return resource
+}
+
+// isEmptyNestedBlock returns whether a nested block in the raw state passed to a StateUpgradeFunc is missing or empty
+func isEmptyNestedBlock(raw interface{}) bool {
+	items, ok := raw.([]interface{})
+	return !ok || len(items) == 0 || items[0] == nil
+}
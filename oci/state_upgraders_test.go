@@ -0,0 +1,44 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitAddStateUpgraders(t *testing.T) {
+	resource := addStateUpgraders(CoreInstanceResource(), upgradeCoreInstanceStateV0)
+	assert.Equal(t, 1, resource.SchemaVersion)
+	assert.Len(t, resource.StateUpgraders, 1)
+	assert.Equal(t, 0, resource.StateUpgraders[0].Version)
+	assert.Nil(t, resource.InternalValidate(nil, true))
+
+	// Resources without upgrade functions are left at version 0
+	resource = addStateUpgraders(CoreInstanceResource())
+	assert.Equal(t, 0, resource.SchemaVersion)
+	assert.Empty(t, resource.StateUpgraders)
+}
+
+func TestUnitUpgradeCoreInstanceStateV0(t *testing.T) {
+	// The deprecated arguments are moved into the nested blocks
+	state, err := upgradeCoreInstanceStateV0(map[string]interface{}{
+		"image":          "ocid1.image.oc1..aaaa",
+		"subnet_id":      "ocid1.subnet.oc1..aaaa",
+		"hostname_label": "host",
+	}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{map[string]interface{}{"source_id": "ocid1.image.oc1..aaaa", "source_type": "image"}}, state["source_details"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"subnet_id": "ocid1.subnet.oc1..aaaa", "hostname_label": "host"}}, state["create_vnic_details"])
+
+	// Nested blocks that are already set are left as they are
+	sourceDetails := []interface{}{map[string]interface{}{"source_id": "ocid1.bootvolume.oc1..aaaa", "source_type": "bootVolume"}}
+	state, err = upgradeCoreInstanceStateV0(map[string]interface{}{
+		"image":          "ocid1.image.oc1..aaaa",
+		"source_details": sourceDetails,
+	}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, sourceDetails, state["source_details"])
+	assert.Nil(t, state["create_vnic_details"])
+}
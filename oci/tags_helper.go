@@ -41,7 +41,7 @@ func mapToDefinedTags(rawMap map[string]interface{}) (map[string]map[string]inte
 }
 
 func definedTagsDiffSuppressFunction(key string, old string, new string, d *schema.ResourceData) bool {
-	if old != "" && new != "" {
+	if old != "" && new != "" && len(ignoredDefinedTags) == 0 {
 		return false
 	}
 
@@ -56,6 +56,11 @@ func definedTagsDiffSuppressFunction(key string, old string, new string, d *sche
 		}
 	}
 
+	// Tags applied outside of Terraform, such as by tag defaults, are ignored when they are in ignore_defined_tags
+	if tagKey := strings.Join(keyParts[len(definedTagKeyParts):], "."); isIgnoredDefinedTag(tagKey) {
+		return true
+	}
+
 	//Old value comes from refreshed state, while new value comes from config
 	oldRaw, newRaw := d.GetChange(strings.Join(definedTagKeyParts, "."))
 	if newRaw == nil || oldRaw == nil {
@@ -68,8 +73,8 @@ func definedTagsDiffSuppressFunction(key string, old string, new string, d *sche
 		return false
 	}
 
-	lowerCaseNewValueMap := toLowerCaseKeyMap(newValue)
-	lowerCaseOldValueMap := toLowerCaseKeyMap(oldValue)
+	lowerCaseNewValueMap := toLowerCaseKeyMap(removeIgnoredDefinedTags(newValue))
+	lowerCaseOldValueMap := toLowerCaseKeyMap(removeIgnoredDefinedTags(oldValue))
 
 	if reflect.DeepEqual(lowerCaseOldValueMap, lowerCaseNewValueMap) {
 		return true
@@ -85,6 +90,164 @@ func toLowerCaseKeyMap(original map[string]interface{}) map[string]interface{} {
 	return lowercaseKeyMap
 }
 
+// Defined tag namespaces or tag keys in the form {namespace}.{key} configured with ignore_defined_tags in the provider block
+var ignoredDefinedTags []string
+
+func isIgnoredDefinedTag(tagKey string) bool {
+	if tagKey == "" || tagKey == "%" {
+		return false
+	}
+	namespace := strings.SplitN(tagKey, ".", 2)[0]
+	for _, ignoredTag := range ignoredDefinedTags {
+		if strings.EqualFold(ignoredTag, tagKey) || strings.EqualFold(ignoredTag, namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+func removeIgnoredDefinedTags(definedTags map[string]interface{}) map[string]interface{} {
+	if len(ignoredDefinedTags) == 0 {
+		return definedTags
+	}
+	result := make(map[string]interface{}, len(definedTags))
+	for key, value := range definedTags {
+		if !isIgnoredDefinedTag(key) {
+			result[key] = value
+		}
+	}
+	return result
+}
+
 func systemTagsToMap(systemTags map[string]map[string]interface{}) map[string]interface{} {
 	return definedTagsToMap(systemTags)
 }
+
+// Tags configured with default_tags in the provider block, merged into every taggable resource at plan time
+var defaultFreeformTags map[string]interface{}
+var defaultDefinedTags map[string]interface{}
+
+// addDefaultTagsCustomizeDiff wraps the CustomizeDiff of a resource so that provider default tags are merged into
+// its tags. Only optional and computed tags can be changed during the diff, other resources are left as they are.
+func addDefaultTagsCustomizeDiff(resource *schema.Resource) {
+	if resource == nil {
+		return
+	}
+	freeformTagsSchema, hasFreeformTags := resource.Schema["freeform_tags"]
+	definedTagsSchema, hasDefinedTags := resource.Schema["defined_tags"]
+	hasFreeformTags = hasFreeformTags && freeformTagsSchema.Type == schema.TypeMap && freeformTagsSchema.Optional && freeformTagsSchema.Computed
+	hasDefinedTags = hasDefinedTags && definedTagsSchema.Type == schema.TypeMap && definedTagsSchema.Optional && definedTagsSchema.Computed
+	if !hasFreeformTags && !hasDefinedTags {
+		return
+	}
+
+	customizeDiff := resource.CustomizeDiff
+	resource.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+		if hasFreeformTags {
+			if err := setDefaultTags(d, "freeform_tags", defaultFreeformTags); err != nil {
+				return err
+			}
+		}
+		if hasDefinedTags {
+			if err := setDefaultTags(d, "defined_tags", defaultDefinedTags); err != nil {
+				return err
+			}
+		}
+		if customizeDiff != nil {
+			return customizeDiff(d, meta)
+		}
+		return nil
+	}
+}
+
+func setDefaultTags(d *schema.ResourceDiff, key string, defaultTags map[string]interface{}) error {
+	if len(defaultTags) == 0 || !d.NewValueKnown(key) {
+		return nil
+	}
+
+	tags, _ := d.Get(key).(map[string]interface{})
+	mergedTags := mergeDefaultTags(defaultTags, tags)
+	if reflect.DeepEqual(toLowerCaseKeyMap(mergedTags), toLowerCaseKeyMap(tags)) {
+		return nil
+	}
+	return d.SetNew(key, mergedTags)
+}
+
+// mergeDefaultTags returns the default tags overridden by the tags set on the resource. Tag keys are case insensitive,
+// so a resource tag replaces a default tag that only differs in case.
+func mergeDefaultTags(defaultTags map[string]interface{}, tags map[string]interface{}) map[string]interface{} {
+	mergedTags := make(map[string]interface{}, len(defaultTags)+len(tags))
+	lowerCaseTags := toLowerCaseKeyMap(tags)
+	for key, value := range defaultTags {
+		if _, ok := lowerCaseTags[strings.ToLower(key)]; !ok {
+			mergedTags[key] = value
+		}
+	}
+	for key, value := range tags {
+		mergedTags[key] = value
+	}
+	return mergedTags
+}
+
+// addDefinedTagsCaseNormalization keeps the case of the defined tag namespaces and keys in the state as they are in
+// the configuration. The services return defined tags with the case of the tag namespace and key definitions, which
+// would otherwise show up as differences in the state. Any defined_tags that do not suppress case differences get
+// definedTagsDiffSuppressFunction.
+func addDefinedTagsCaseNormalization(resource *schema.Resource) {
+	if resource == nil {
+		return
+	}
+	definedTagsSchema, ok := resource.Schema["defined_tags"]
+	if !ok || definedTagsSchema.Type != schema.TypeMap || !(definedTagsSchema.Optional || definedTagsSchema.Required) {
+		return
+	}
+	if definedTagsSchema.DiffSuppressFunc == nil {
+		definedTagsSchema.DiffSuppressFunc = definedTagsDiffSuppressFunction
+	}
+
+	resource.Create = withDefinedTagsCase(resource.Create)
+	resource.Read = withDefinedTagsCase(resource.Read)
+	resource.Update = withDefinedTagsCase(resource.Update)
+}
+
+func withDefinedTagsCase(fn func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if fn == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, m interface{}) error {
+		// Before a create or update these are the tags in the configuration, before a read the tags in the state
+		priorTags, _ := d.Get("defined_tags").(map[string]interface{})
+		if err := fn(d, m); err != nil {
+			return err
+		}
+		if d.Id() == "" || len(priorTags) == 0 {
+			return nil
+		}
+
+		tags, _ := d.Get("defined_tags").(map[string]interface{})
+		if normalizedTags, changed := normalizeDefinedTagsCase(tags, priorTags); changed {
+			return d.Set("defined_tags", normalizedTags)
+		}
+		return nil
+	}
+}
+
+// normalizeDefinedTagsCase returns the tags with the keys that only differ in case from a key of the prior tags
+// replaced by that key
+func normalizeDefinedTagsCase(tags map[string]interface{}, priorTags map[string]interface{}) (map[string]interface{}, bool) {
+	priorKeys := make(map[string]string, len(priorTags))
+	for key := range priorTags {
+		priorKeys[strings.ToLower(key)] = key
+	}
+
+	changed := false
+	normalizedTags := make(map[string]interface{}, len(tags))
+	for key, value := range tags {
+		if priorKey, ok := priorKeys[strings.ToLower(key)]; ok && priorKey != key {
+			key = priorKey
+			changed = true
+		}
+		normalizedTags[key] = value
+	}
+	return normalizedTags, changed
+}
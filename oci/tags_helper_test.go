@@ -0,0 +1,118 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package oci
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestUnitMergeDefaultTags(t *testing.T) {
+	defaultTags := map[string]interface{}{"Department": "Finance", "CostCenter": "42"}
+
+	type testCase struct {
+		tags     map[string]interface{}
+		expected map[string]interface{}
+	}
+	testCases := []testCase{
+		{
+			tags:     nil,
+			expected: map[string]interface{}{"Department": "Finance", "CostCenter": "42"},
+		},
+		{
+			tags:     map[string]interface{}{"Owner": "ops"},
+			expected: map[string]interface{}{"Department": "Finance", "CostCenter": "42", "Owner": "ops"},
+		},
+		{
+			tags:     map[string]interface{}{"department": "Accounting"},
+			expected: map[string]interface{}{"department": "Accounting", "CostCenter": "42"},
+		},
+	}
+
+	for i, test := range testCases {
+		if result := mergeDefaultTags(defaultTags, test.tags); !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("test case %d: expected %v, got %v", i, test.expected, result)
+		}
+	}
+}
+
+func TestUnitAddDefaultTagsCustomizeDiff(t *testing.T) {
+	taggable := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"freeform_tags": {Type: schema.TypeMap, Optional: true, Computed: true, Elem: schema.TypeString},
+		},
+	}
+	addDefaultTagsCustomizeDiff(taggable)
+	if taggable.CustomizeDiff == nil {
+		t.Errorf("expected CustomizeDiff to be set for a resource with optional and computed tags")
+	}
+
+	notComputed := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"freeform_tags": {Type: schema.TypeMap, Optional: true, Elem: schema.TypeString},
+		},
+	}
+	addDefaultTagsCustomizeDiff(notComputed)
+	if notComputed.CustomizeDiff != nil {
+		t.Errorf("expected CustomizeDiff not to be set for a resource with tags that are not computed")
+	}
+}
+
+func TestUnitIsIgnoredDefinedTag(t *testing.T) {
+	ignoredDefinedTags = []string{"Oracle-Tags", "Operations.CreatedBy"}
+	defer func() { ignoredDefinedTags = nil }()
+
+	type testCase struct {
+		tagKey   string
+		expected bool
+	}
+	testCases := []testCase{
+		{tagKey: "Oracle-Tags.CreatedOn", expected: true},
+		{tagKey: "oracle-tags.CreatedBy", expected: true},
+		{tagKey: "Operations.CreatedBy", expected: true},
+		{tagKey: "Operations.CostCenter", expected: false},
+		{tagKey: "%", expected: false},
+		{tagKey: "", expected: false},
+	}
+
+	for _, test := range testCases {
+		if result := isIgnoredDefinedTag(test.tagKey); result != test.expected {
+			t.Errorf("expected %v for tag key '%s', got %v", test.expected, test.tagKey, result)
+		}
+	}
+
+	tags := map[string]interface{}{"Oracle-Tags.CreatedOn": "2020-01-01", "Operations.CostCenter": "42"}
+	if result := removeIgnoredDefinedTags(tags); !reflect.DeepEqual(result, map[string]interface{}{"Operations.CostCenter": "42"}) {
+		t.Errorf("unexpected tags after removing ignored tags: %v", result)
+	}
+}
+
+func TestUnitAddDefinedTagsCaseNormalization(t *testing.T) {
+	taggable := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"defined_tags": {Type: schema.TypeMap, Optional: true, Computed: true, Elem: schema.TypeString},
+		},
+		// The service returns defined tags with the case of the tag namespace and key definitions
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			d.SetId("ocid1.test.oc1..aaaa")
+			return d.Set("defined_tags", map[string]interface{}{"Operations.CostCenter": "42", "Oracle-Tags.CreatedBy": "user"})
+		},
+	}
+	addDefinedTagsCaseNormalization(taggable)
+	if taggable.Schema["defined_tags"].DiffSuppressFunc == nil {
+		t.Errorf("expected DiffSuppressFunc to be set for defined_tags")
+	}
+
+	d := schema.TestResourceDataRaw(t, taggable.Schema, map[string]interface{}{
+		"defined_tags": map[string]interface{}{"operations.costcenter": "42"},
+	})
+	if err := taggable.Create(d, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]interface{}{"operations.costcenter": "42", "Oracle-Tags.CreatedBy": "user"}
+	if result := d.Get("defined_tags"); !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected defined tags %v, got %v", expected, result)
+	}
+}
@@ -19,11 +19,30 @@ var (
 
 	secretDataSourceRepresentation = map[string]interface{}{
 		"compartment_id": Representation{repType: Required, create: `${var.compartment_id}`},
-		"name":           Representation{repType: Optional, create: `${oci_vault_secret.test_secret.name}`},
-		"vault_id":       Representation{repType: Optional, create: `${oci_kms_vault.test_vault.id}`},
+		"name":           Representation{repType: Optional, create: `${oci_vault_secret.test_secret.secret_name}`},
+		"vault_id":       Representation{repType: Optional, create: `${data.oci_kms_vault.test_vault.id}`},
 	}
 
-	SecretResourceDependencies = DefinedTagsDependencies
+	secretRepresentation = map[string]interface{}{
+		"compartment_id": Representation{repType: Required, create: `${var.compartment_id}`},
+		"secret_content": RepresentationGroup{Required, secretSecretContentRepresentation},
+		"secret_name":    Representation{repType: Required, create: `TFsample`},
+		"vault_id":       Representation{repType: Required, create: `${data.oci_kms_vault.test_vault.id}`},
+		"description":    Representation{repType: Optional, create: `description`, update: `description2`},
+		"freeform_tags":  Representation{repType: Optional, create: map[string]string{"Department": "Finance"}, update: map[string]string{"Department": "Accounting"}},
+		"key_id":         Representation{repType: Optional, create: `${lookup(data.oci_kms_keys.test_keys_dependency.keys[0], "id")}`},
+		"secret_rules":   RepresentationGroup{Optional, secretSecretRulesRepresentation},
+	}
+	secretSecretContentRepresentation = map[string]interface{}{
+		"content":      Representation{repType: Required, create: `${base64encode("secret")}`, update: `${base64encode("secret2")}`},
+		"content_type": Representation{repType: Required, create: `BASE64`},
+	}
+	secretSecretRulesRepresentation = map[string]interface{}{
+		"rule_type":                              Representation{repType: Required, create: `SECRET_REUSE_RULE`},
+		"is_enforced_on_deleted_secret_versions": Representation{repType: Optional, create: `false`, update: `true`},
+	}
+
+	SecretResourceDependencies = DefinedTagsDependencies + KeyResourceDependencyConfig
 )
 
 func TestVaultSecretResource_basic(t *testing.T) {
@@ -37,6 +56,7 @@ func TestVaultSecretResource_basic(t *testing.T) {
 	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
 	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
 
+	resourceName := "oci_vault_secret.test_secret"
 	datasourceName := "data.oci_vault_secrets.test_secrets"
 	singularDatasourceName := "data.oci_vault_secret.test_secret"
 
@@ -46,11 +66,42 @@ func TestVaultSecretResource_basic(t *testing.T) {
 			"oci": provider,
 		},
 		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config + compartmentIdVariableStr + SecretResourceDependencies +
+					generateResourceFromRepresentationMap("oci_vault_secret", "test_secret", Optional, Create, secretRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+					resource.TestCheckResourceAttrSet(resourceName, "current_version_number"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description"),
+					resource.TestCheckResourceAttr(resourceName, "freeform_tags.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_id"),
+					resource.TestCheckResourceAttr(resourceName, "secret_name", "TFsample"),
+					resource.TestCheckResourceAttr(resourceName, "secret_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "secret_rules.0.is_enforced_on_deleted_secret_versions", "false"),
+					resource.TestCheckResourceAttr(resourceName, "secret_rules.0.rule_type", "SECRET_REUSE_RULE"),
+					resource.TestCheckResourceAttrSet(resourceName, "state"),
+					resource.TestCheckResourceAttrSet(resourceName, "vault_id"),
+				),
+			},
+			// verify updates to updatable parameters, a new secret version is created instead of recreating the secret
+			{
+				Config: config + compartmentIdVariableStr + SecretResourceDependencies +
+					generateResourceFromRepresentationMap("oci_vault_secret", "test_secret", Optional, Update, secretRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "current_version_number", "2"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "freeform_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "secret_rules.0.is_enforced_on_deleted_secret_versions", "true"),
+				),
+			},
 			// verify datasource
 			{
 				Config: config +
 					generateDataSourceFromRepresentationMap("oci_vault_secrets", "test_secrets", Optional, Update, secretDataSourceRepresentation) +
-					compartmentIdVariableStr + SecretResourceDependencies,
+					compartmentIdVariableStr + SecretResourceDependencies +
+					generateResourceFromRepresentationMap("oci_vault_secret", "test_secret", Optional, Update, secretRepresentation),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(datasourceName, "compartment_id", compartmentId),
 					resource.TestCheckResourceAttrSet(datasourceName, "name"),
@@ -74,7 +125,8 @@ func TestVaultSecretResource_basic(t *testing.T) {
 			{
 				Config: config +
 					generateDataSourceFromRepresentationMap("oci_vault_secret", "test_secret", Required, Create, secretSingularDataSourceRepresentation) +
-					compartmentIdVariableStr,
+					compartmentIdVariableStr + SecretResourceDependencies +
+					generateResourceFromRepresentationMap("oci_vault_secret", "test_secret", Optional, Update, secretRepresentation),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet(singularDatasourceName, "secret_id"),
 
@@ -83,7 +135,7 @@ func TestVaultSecretResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(singularDatasourceName, "description"),
 					resource.TestCheckResourceAttrSet(singularDatasourceName, "id"),
 					resource.TestCheckResourceAttr(singularDatasourceName, "metadata.%", "0"),
-					resource.TestCheckResourceAttr(singularDatasourceName, "secret_rules.#", "0"),
+					resource.TestCheckResourceAttr(singularDatasourceName, "secret_rules.#", "1"),
 					resource.TestCheckResourceAttrSet(singularDatasourceName, "state"),
 					resource.TestCheckResourceAttrSet(singularDatasourceName, "time_created"),
 					resource.TestCheckResourceAttrSet(singularDatasourceName, "time_of_deletion"),
@@ -326,6 +326,7 @@ func httpRedirectWaitForWorkRequest(wId *string, entityType string, action oci_w
 		},
 		Timeout: timeout,
 	}
+	setPollInterval(stateConf, providerPollInterval)
 	if _, e := stateConf.WaitForState(); e != nil {
 		return nil, e
 	}
@@ -344,20 +345,26 @@ func httpRedirectWaitForWorkRequest(wId *string, entityType string, action oci_w
 	// The workrequest didn't do all its intended tasks, if the errors is set; so we should check for it
 	var workRequestErr error
 	if len(response.Errors) > 0 {
-		errorMessage := getErrorFromHttpRedirectWorkRequest(response)
-		workRequestErr = fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *wId, entityType, action, errorMessage)
+		workRequestErr = getErrorFromHttpRedirectWorkRequest(response, wId, entityType, action)
 	}
 
 	return identifier, workRequestErr
 }
 
-func getErrorFromHttpRedirectWorkRequest(response oci_waas.GetWorkRequestResponse) string {
+func getErrorFromHttpRedirectWorkRequest(response oci_waas.GetWorkRequestResponse, wId *string, entityType string, action oci_waas.WorkRequestResourceActionTypeEnum) error {
 	allErrs := make([]string, 0)
 	for _, wrkErr := range response.Errors {
 		allErrs = append(allErrs, *wrkErr.Message)
 	}
-	errorMessage := strings.Join(allErrs, "\n")
-	return errorMessage
+
+	logs := make([]string, 0)
+	for _, logEntry := range response.Logs {
+		if logEntry.Message != nil {
+			logs = append(logs, *logEntry.Message)
+		}
+	}
+
+	return newWorkRequestError(wId, entityType, string(action), response.OpcRequestId, allErrs, logs)
 }
 
 func (s *WaasHttpRedirectResourceCrud) Get() error {
@@ -1523,6 +1523,7 @@ func waasPolicyWaitForWorkRequest(wId *string, entityType string, action oci_waa
 		},
 		Timeout: timeout,
 	}
+	setPollInterval(stateConf, providerPollInterval)
 	if _, e := stateConf.WaitForState(); e != nil {
 		return nil, e
 	}
@@ -1541,20 +1542,26 @@ func waasPolicyWaitForWorkRequest(wId *string, entityType string, action oci_waa
 	// The workrequest didn't do all its intended tasks, if the errors is set; so we should check for it
 	var workRequestErr error
 	if len(response.Errors) > 0 {
-		errorMessage := getErrorFromWaasPolicyWorkRequest(response)
-		workRequestErr = fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *wId, entityType, action, errorMessage)
+		workRequestErr = getErrorFromWaasPolicyWorkRequest(response, wId, entityType, action)
 	}
 
 	return identifier, workRequestErr
 }
 
-func getErrorFromWaasPolicyWorkRequest(response oci_waas.GetWorkRequestResponse) string {
+func getErrorFromWaasPolicyWorkRequest(response oci_waas.GetWorkRequestResponse, wId *string, entityType string, action oci_waas.WorkRequestResourceActionTypeEnum) error {
 	allErrs := make([]string, 0)
 	for _, wrkErr := range response.Errors {
 		allErrs = append(allErrs, *wrkErr.Message)
 	}
-	errorMessage := strings.Join(allErrs, "\n")
-	return errorMessage
+
+	logs := make([]string, 0)
+	for _, logEntry := range response.Logs {
+		if logEntry.Message != nil {
+			logs = append(logs, *logEntry.Message)
+		}
+	}
+
+	return newWorkRequestError(wId, entityType, string(action), response.OpcRequestId, allErrs, logs)
 }
 
 func (s *WaasWaasPolicyResourceCrud) mapToOrigin(fieldKeyFormat string) (oci_waas.Origin, error) {